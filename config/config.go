@@ -29,12 +29,116 @@ If any section has no content, omit it. Be concise but don't miss important deta
 // Available placeholders: {{.Year}}, {{.Month}}, {{.Day}}, {{.Hour}}, {{.Minute}}, {{.Second}}, {{.Name}}
 const DefaultFolderTemplate = "{{.Year}}-{{.Month}}-{{.Day}}_{{.Hour}}-{{.Minute}}-{{.Second}}{{if .Name}}_{{.Name}}{{end}}"
 
+// DefaultTranscriptTemplate is the default layout of transcript.md. It is
+// executed with usecases.TranscriptTemplateData: .Turns, each with .Speaker,
+// .Timestamp (hh:mm:ss) and .Paragraphs (.Timestamp, .Text), and .Text for
+// transcripts without timestamps.
+const DefaultTranscriptTemplate = `# Meeting Transcript
+{{range .Turns}}
+**[{{.Timestamp}}]{{if .Speaker}} {{.Speaker}}:{{end}}**
+{{range $i, $p := .Paragraphs}}{{if $i}}[{{$p.Timestamp}}] {{end}}{{$p.Text}}
+{{end}}{{else}}
+{{.Text}}
+{{end}}`
+
+// Audio backends selectable via audio_backend.
+const (
+	AudioBackendNative = "native" // ScreenCaptureKit/PulseAudio + ffmpeg
+	AudioBackendFile   = "file"   // replay existing WAV files
+	AudioBackendNull   = "null"   // record silence
+)
+
+// Transcription providers selectable via [transcription] provider.
+const (
+	TranscriptionMistral = "mistral"
+	TranscriptionOpenAI  = "openai"     // any OpenAI-compatible endpoint
+	TranscriptionWhisper = "whispercpp" // local whisper.cpp CLI or server
+)
+
+// Summarization providers.
+const (
+	SummarizationAnthropic = "anthropic"
+	SummarizationOpenAI    = "openai" // any OpenAI-compatible chat endpoint
+	SummarizationOllama    = "ollama"
+)
+
+// Embedding providers for vector search, selectable via [search] provider.
+const (
+	EmbeddingsOpenAI = "openai" // any OpenAI-compatible embeddings endpoint
+	EmbeddingsOllama = "ollama"
+)
+
+// SearchConfig holds the [search] table.
+type SearchConfig struct {
+	Provider string // embeddings for vector search; empty searches full text only
+	BaseURL  string // empty uses the provider default
+	Model    string // empty uses the provider default
+	APIKey   string // for the openai provider
+}
+
+// SummarizationConfig holds the [summarization] table.
+type SummarizationConfig struct {
+	Provider       string
+	BaseURL        string   // empty uses the provider default
+	Model          string   // empty uses the provider default
+	APIKey         string   // for the openai provider; anthropic uses AnthropicKey
+	MaxTokens      int      // maximum length of the summary
+	Temperature    *float64 // nil uses the provider default
+	MaxInputTokens int      // larger transcripts (estimated) are summarized in parts; 0 disables
+	Concurrency    int      // summary and outputs generated in parallel
+}
+
+// SubtitlesConfig holds the [subtitles] table.
+type SubtitlesConfig struct {
+	Formats    []string // written automatically after transcribing: srt, vtt
+	MaxChars   int      // maximum characters per caption
+	MaxSeconds int      // maximum duration of a caption
+}
+
+// TranscriptionConfig holds the [transcription] table.
+type TranscriptionConfig struct {
+	Provider            string
+	BaseURL             string // empty uses the provider default
+	Model               string // empty uses the provider default
+	APIKey              string // for the openai provider; mistral uses MistralAPIKey
+	Binary              string // whisper.cpp CLI for the whispercpp provider
+	ModelPath           string // whisper.cpp ggml model file
+	ChunkMinutes        int    // split recordings longer than this; 0 disables
+	ChunkOverlapSeconds int    // overlap between consecutive chunks
+	Concurrency         int    // chunks transcribed in parallel
+	SeparateTracks      bool   // transcribe mic and system audio separately ("Me" vs "Them")
+}
+
 type Config struct {
 	MeetingsDir    string
 	MistralAPIKey  string
 	AnthropicKey   string
 	SummaryPrompt  string // system prompt for summary generation
 	FolderTemplate string // Go template for meeting folder names
+	AudioBackend   string // native, file or null
+	ReplaySystem   string // system WAV replayed by the file backend
+	ReplayMic      string // mic WAV replayed by the file backend
+	SegmentMinutes int    // rotate capture files every N minutes; 0 disables
+	Transcription  TranscriptionConfig
+	Summarization  SummarizationConfig
+	Subtitles      SubtitlesConfig
+
+	// TranscriptTemplate is the Go template for transcript.md. Inside long
+	// turns a timestamp marker is added every TranscriptMarkerSeconds; 0 disables.
+	TranscriptTemplate      string
+	TranscriptMarkerSeconds int
+
+	ExtractActions bool // extract structured action items into actions.json
+
+	// Templates are named summary prompts from [templates.<name>] and the
+	// templates/ directory next to config.toml. DefaultTemplate is always
+	// present and uses SummaryPrompt unless redefined.
+	Templates map[string]SummaryTemplate
+
+	// Outputs are generated next to summary.md, keyed by name.
+	Outputs map[string]SummaryOutput
+
+	Search SearchConfig
 }
 
 type fileConfig struct {
@@ -43,15 +147,94 @@ type fileConfig struct {
 	AnthropicKey   string `toml:"anthropic_api_key"`
 	SummaryPrompt  string `toml:"summary_prompt"`
 	FolderTemplate string `toml:"folder_template"`
+	AudioBackend   string `toml:"audio_backend"`
+	ReplaySystem   string `toml:"replay_system_path"`
+	ReplayMic      string `toml:"replay_mic_path"`
+	SegmentMinutes int    `toml:"segment_minutes"`
+
+	Transcription *fileTranscriptionConfig `toml:"transcription"`
+	Summarization *fileSummarizationConfig `toml:"summarization"`
+	Subtitles     *fileSubtitlesConfig     `toml:"subtitles"`
+
+	TranscriptTemplate      string `toml:"transcript_template"`
+	TranscriptMarkerSeconds int    `toml:"transcript_marker_seconds"`
+	ExtractActions          *bool  `toml:"extract_actions"`
+
+	Templates map[string]fileSummaryTemplate `toml:"templates"`
+	Outputs   map[string]fileSummaryOutput   `toml:"outputs"`
+
+	Search *fileSearchConfig `toml:"search"`
+}
+
+// fileTranscriptionConfig uses pointers so an explicit 0 can be told apart
+// from an unset key.
+type fileTranscriptionConfig struct {
+	Provider            string `toml:"provider"`
+	BaseURL             string `toml:"base_url"`
+	Model               string `toml:"model"`
+	APIKey              string `toml:"api_key"`
+	Binary              string `toml:"binary"`
+	ModelPath           string `toml:"model_path"`
+	ChunkMinutes        *int   `toml:"chunk_minutes"`
+	ChunkOverlapSeconds *int   `toml:"chunk_overlap_seconds"`
+	Concurrency         *int   `toml:"concurrency"`
+	SeparateTracks      bool   `toml:"separate_tracks"`
+}
+
+type fileSummarizationConfig struct {
+	Provider       string   `toml:"provider"`
+	BaseURL        string   `toml:"base_url"`
+	Model          string   `toml:"model"`
+	APIKey         string   `toml:"api_key"`
+	MaxTokens      int      `toml:"max_tokens"`
+	Temperature    *float64 `toml:"temperature"`
+	MaxInputTokens *int     `toml:"max_input_tokens"`
+	Concurrency    int      `toml:"concurrency"`
+}
+
+type fileSearchConfig struct {
+	Provider string `toml:"provider"`
+	BaseURL  string `toml:"base_url"`
+	Model    string `toml:"model"`
+	APIKey   string `toml:"api_key"`
+}
+
+type fileSubtitlesConfig struct {
+	Formats    []string `toml:"formats"`
+	MaxChars   int      `toml:"max_chars"`
+	MaxSeconds int      `toml:"max_seconds"`
 }
 
 func Load() (*Config, error) {
 	cfg := &Config{
-		MeetingsDir:    defaultMeetingsDir(),
-		SummaryPrompt:  DefaultSummaryPrompt,
-		FolderTemplate: DefaultFolderTemplate,
+		MeetingsDir:        defaultMeetingsDir(),
+		SummaryPrompt:      DefaultSummaryPrompt,
+		FolderTemplate:     DefaultFolderTemplate,
+		AudioBackend:       AudioBackendNative,
+		TranscriptTemplate: DefaultTranscriptTemplate,
+		ExtractActions:     true,
+		Transcription: TranscriptionConfig{
+			Provider:            TranscriptionMistral,
+			ChunkMinutes:        10,
+			ChunkOverlapSeconds: 20,
+			Concurrency:         3,
+		},
+		Summarization: SummarizationConfig{
+			Provider:       SummarizationAnthropic,
+			MaxTokens:      4096,
+			MaxInputTokens: 100_000,
+			Concurrency:    3,
+		},
+		Subtitles: SubtitlesConfig{
+			MaxChars:   84,
+			MaxSeconds: 6,
+		},
 	}
 
+	// Files in templates/ come first so [templates.<name>] can override them.
+	cfg.Templates = make(map[string]SummaryTemplate)
+	loadTemplateDir(cfg.Templates)
+
 	if configPath := configFilePath(); configPath != "" {
 		var fc fileConfig
 		if _, err := toml.DecodeFile(configPath, &fc); err == nil {
@@ -66,11 +249,108 @@ func Load() (*Config, error) {
 			if fc.FolderTemplate != "" {
 				cfg.FolderTemplate = fc.FolderTemplate
 			}
+			if fc.TranscriptTemplate != "" {
+				cfg.TranscriptTemplate = fc.TranscriptTemplate
+			}
+			cfg.TranscriptMarkerSeconds = fc.TranscriptMarkerSeconds
+			if fc.ExtractActions != nil {
+				cfg.ExtractActions = *fc.ExtractActions
+			}
+			for name, t := range fc.Templates {
+				if t.Prompt == "" {
+					continue
+				}
+				cfg.Templates[name] = SummaryTemplate{
+					Description: t.Description,
+					Prompt:      t.Prompt,
+					Source:      configPath,
+				}
+			}
+			for name, o := range fc.Outputs {
+				if o.Prompt == "" {
+					continue
+				}
+				if o.File == "" {
+					o.File = name + ".md"
+				}
+				if cfg.Outputs == nil {
+					cfg.Outputs = make(map[string]SummaryOutput)
+				}
+				cfg.Outputs[name] = SummaryOutput{Prompt: o.Prompt, File: o.File}
+			}
+			if fc.AudioBackend != "" {
+				cfg.AudioBackend = fc.AudioBackend
+			}
+			cfg.ReplaySystem = expandTilde(fc.ReplaySystem)
+			cfg.ReplayMic = expandTilde(fc.ReplayMic)
+			cfg.SegmentMinutes = fc.SegmentMinutes
+			if t := fc.Transcription; t != nil {
+				if t.Provider != "" {
+					cfg.Transcription.Provider = t.Provider
+				}
+				cfg.Transcription.BaseURL = t.BaseURL
+				cfg.Transcription.Model = t.Model
+				cfg.Transcription.APIKey = t.APIKey
+				cfg.Transcription.Binary = expandTilde(t.Binary)
+				cfg.Transcription.ModelPath = expandTilde(t.ModelPath)
+				if t.ChunkMinutes != nil {
+					cfg.Transcription.ChunkMinutes = *t.ChunkMinutes
+				}
+				if t.ChunkOverlapSeconds != nil {
+					cfg.Transcription.ChunkOverlapSeconds = *t.ChunkOverlapSeconds
+				}
+				if t.Concurrency != nil {
+					cfg.Transcription.Concurrency = *t.Concurrency
+				}
+				cfg.Transcription.SeparateTracks = t.SeparateTracks
+			}
+			if s := fc.Summarization; s != nil {
+				if s.Provider != "" {
+					cfg.Summarization.Provider = s.Provider
+				}
+				cfg.Summarization.BaseURL = s.BaseURL
+				cfg.Summarization.Model = s.Model
+				cfg.Summarization.APIKey = s.APIKey
+				if s.MaxTokens > 0 {
+					cfg.Summarization.MaxTokens = s.MaxTokens
+				}
+				cfg.Summarization.Temperature = s.Temperature
+				if s.MaxInputTokens != nil {
+					cfg.Summarization.MaxInputTokens = *s.MaxInputTokens
+				}
+				if s.Concurrency > 0 {
+					cfg.Summarization.Concurrency = s.Concurrency
+				}
+			}
+			if s := fc.Search; s != nil {
+				cfg.Search = SearchConfig{
+					Provider: s.Provider,
+					BaseURL:  s.BaseURL,
+					Model:    s.Model,
+					APIKey:   s.APIKey,
+				}
+			}
+			if s := fc.Subtitles; s != nil {
+				cfg.Subtitles.Formats = s.Formats
+				if s.MaxChars > 0 {
+					cfg.Subtitles.MaxChars = s.MaxChars
+				}
+				if s.MaxSeconds > 0 {
+					cfg.Subtitles.MaxSeconds = s.MaxSeconds
+				}
+			}
 		}
 	}
 
 	applyEnvOverrides(cfg)
 
+	if _, ok := cfg.Templates[DefaultTemplate]; !ok {
+		cfg.Templates[DefaultTemplate] = SummaryTemplate{
+			Description: "General meeting summary (summary_prompt)",
+			Prompt:      cfg.SummaryPrompt,
+		}
+	}
+
 	// Ensure directories exist
 	if err := os.MkdirAll(cfg.MeetingsDir, 0o755); err != nil {
 		return nil, err
@@ -89,15 +369,40 @@ func applyEnvOverrides(cfg *Config) {
 	if v := os.Getenv("MEETINGCLI_MEETINGS_DIR"); v != "" {
 		cfg.MeetingsDir = expandTilde(v)
 	}
+	if v := os.Getenv("MEETINGCLI_TRANSCRIPTION_API_KEY"); v != "" {
+		cfg.Transcription.APIKey = v
+	}
+	if v := os.Getenv("MEETINGCLI_SUMMARIZATION_API_KEY"); v != "" {
+		cfg.Summarization.APIKey = v
+	}
+	if v := os.Getenv("MEETINGCLI_SEARCH_API_KEY"); v != "" {
+		cfg.Search.APIKey = v
+	}
+	if v := os.Getenv("MEETINGCLI_AUDIO_BACKEND"); v != "" {
+		cfg.AudioBackend = v
+	}
+	if v := os.Getenv("MEETINGCLI_REPLAY_SYSTEM_PATH"); v != "" {
+		cfg.ReplaySystem = expandTilde(v)
+	}
+	if v := os.Getenv("MEETINGCLI_REPLAY_MIC_PATH"); v != "" {
+		cfg.ReplayMic = expandTilde(v)
+	}
 }
 
-func configFilePath() string {
-	var configDir string
+// configDir returns the meetingcli config directory, or "" if there is none.
+func configDir() string {
 	if xdg := os.Getenv("XDG_CONFIG_HOME"); xdg != "" {
-		configDir = filepath.Join(xdg, "meetingcli")
-	} else if home, err := os.UserHomeDir(); err == nil {
-		configDir = filepath.Join(home, ".config", "meetingcli")
-	} else {
+		return filepath.Join(xdg, "meetingcli")
+	}
+	if home, err := os.UserHomeDir(); err == nil {
+		return filepath.Join(home, ".config", "meetingcli")
+	}
+	return ""
+}
+
+func configFilePath() string {
+	configDir := configDir()
+	if configDir == "" {
 		return ""
 	}
 
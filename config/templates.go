@@ -0,0 +1,69 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// DefaultTemplate is the name of the built-in template, whose prompt is
+// summary_prompt.
+const DefaultTemplate = "default"
+
+// SummaryTemplate is a named summary prompt for one kind of meeting.
+type SummaryTemplate struct {
+	Description string
+	Prompt      string
+	Source      string // config file or template file it was defined in
+}
+
+type fileSummaryTemplate struct {
+	Description string `toml:"description"`
+	Prompt      string `toml:"prompt"`
+}
+
+// SummaryOutput is an additional file generated by summarize from
+// [outputs.<name>], such as a TL;DR or a follow-up email draft.
+type SummaryOutput struct {
+	Prompt string
+	File   string // in the meeting directory; defaults to <name>.md
+}
+
+type fileSummaryOutput struct {
+	Prompt string `toml:"prompt"`
+	File   string `toml:"filename"`
+}
+
+// templateExtensions are the files read from the templates directory.
+var templateExtensions = []string{".md", ".txt"}
+
+// loadTemplateDir reads templates/<name>.md (or .txt) from the config
+// directory, where the whole file is the prompt.
+func loadTemplateDir(templates map[string]SummaryTemplate) {
+	dir := configDir()
+	if dir == "" {
+		return
+	}
+	dir = filepath.Join(dir, "templates")
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return
+	}
+	for _, e := range entries {
+		ext := filepath.Ext(e.Name())
+		if e.IsDir() || !slices.Contains(templateExtensions, ext) {
+			continue
+		}
+		path := filepath.Join(dir, e.Name())
+		data, err := os.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		templates[strings.TrimSuffix(e.Name(), ext)] = SummaryTemplate{
+			Prompt: strings.TrimSpace(string(data)),
+			Source: path,
+		}
+	}
+}
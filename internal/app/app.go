@@ -1,36 +1,247 @@
 package app
 
 import (
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
 	"github.com/devbydaniel/meetingcli/config"
 	"github.com/devbydaniel/meetingcli/internal/audio"
+	"github.com/devbydaniel/meetingcli/internal/domain/meeting"
 	"github.com/devbydaniel/meetingcli/internal/domain/meeting/usecases"
+	"github.com/devbydaniel/meetingcli/internal/embedder"
+	"github.com/devbydaniel/meetingcli/internal/summarizer"
+	"github.com/devbydaniel/meetingcli/internal/transcriber"
 )
 
 type App struct {
+	Meetings   *meeting.Repository
 	Record     *usecases.Record
+	Import     *usecases.Import
+	Recover    *usecases.Recover
 	Transcribe *usecases.Transcribe
 	Summarize  *usecases.Summarize
+	Export     *usecases.Export
+	Speakers   *usecases.Speakers
+	Actions    *usecases.Actions
+	Ask        *usecases.Ask
+	Search     *usecases.Search
+	List       *usecases.List
 }
 
 func New(cfg *config.Config) (*App, error) {
-	capturer, err := audio.NewSystemAudioCapturer()
+	meetings := meeting.NewRepository(cfg.MeetingsDir)
+
+	record, err := newRecord(cfg, meetings)
+	if err != nil {
+		return nil, err
+	}
+
+	transcriptionProvider, err := newTranscriber(cfg)
 	if err != nil {
 		return nil, err
 	}
 
+	summarizationProvider, err := newSummarizer(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	embeddingProvider, err := newEmbedder(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	export := &usecases.Export{
+		MaxChars:    cfg.Subtitles.MaxChars,
+		MaxDuration: time.Duration(cfg.Subtitles.MaxSeconds) * time.Second,
+		Meetings:    meetings,
+	}
+	search := &usecases.Search{
+		Meetings: meetings,
+		Embedder: embeddingProvider,
+	}
+	transcribe := &usecases.Transcribe{
+		Transcriber:     transcriptionProvider,
+		Meetings:        meetings,
+		Splitter:        audio.NewWAVSplitter(),
+		ChunkDuration:   time.Duration(cfg.Transcription.ChunkMinutes) * time.Minute,
+		ChunkOverlap:    time.Duration(cfg.Transcription.ChunkOverlapSeconds) * time.Second,
+		Concurrency:     cfg.Transcription.Concurrency,
+		SeparateTracks:  cfg.Transcription.SeparateTracks,
+		Template:        cfg.TranscriptTemplate,
+		MarkerInterval:  time.Duration(cfg.TranscriptMarkerSeconds) * time.Second,
+		Subtitles:       export,
+		SubtitleFormats: cfg.Subtitles.Formats,
+	}
+
 	return &App{
-		Record: &usecases.Record{
-			Capturer:       capturer,
-			Recorder:       audio.NewRecorder(),
+		Meetings: meetings,
+		Record:   record,
+		Import: &usecases.Import{
+			Converter:      audio.NewRecorder(),
+			Prober:         audio.NewWAVProber(),
+			Meetings:       meetings,
 			MeetingsDir:    cfg.MeetingsDir,
 			FolderTemplate: cfg.FolderTemplate,
 		},
-		Transcribe: &usecases.Transcribe{
-			APIKey: cfg.MistralAPIKey,
+		Recover: &usecases.Recover{
+			Repairer: audio.NewWAVRepairer(),
+			Merger:   record.Merger,
+			Stitcher: record.Stitcher,
+			Prober:   audio.NewWAVProber(),
+			Meetings: meetings,
+			Segment:  record.Segment,
 		},
+		Transcribe: transcribe,
 		Summarize: &usecases.Summarize{
-			APIKey:       cfg.AnthropicKey,
-			SystemPrompt: cfg.SummaryPrompt,
+			Summarizer:      summarizationProvider,
+			Templates:       templatePrompts(cfg.Templates),
+			DefaultTemplate: config.DefaultTemplate,
+			Meetings:        meetings,
+			TokenBudget:     cfg.Summarization.MaxInputTokens,
+			Outputs:         summaryOutputs(cfg.Outputs),
+			Concurrency:     cfg.Summarization.Concurrency,
+		},
+		Export: export,
+		Speakers: &usecases.Speakers{
+			Meetings:   meetings,
+			Transcribe: transcribe,
+			Export:     export,
+		},
+		Actions: &usecases.Actions{
+			Summarizer:  summarizationProvider,
+			Meetings:    meetings,
+			TokenBudget: cfg.Summarization.MaxInputTokens,
+		},
+		Ask: &usecases.Ask{
+			Chatter:     summarizationProvider,
+			Meetings:    meetings,
+			Search:      search,
+			TokenBudget: cfg.Summarization.MaxInputTokens,
+		},
+		Search: search,
+		List: &usecases.List{
+			Meetings: meetings,
+			Prober:   audio.NewWAVProber(),
 		},
 	}, nil
 }
+
+// newRecord wires the Record use case to the configured audio backend.
+func newRecord(cfg *config.Config, meetings *meeting.Repository) (*usecases.Record, error) {
+	record := &usecases.Record{
+		Stitcher:       audio.NewWAVStitcher(),
+		Prober:         audio.NewWAVProber(),
+		Meetings:       meetings,
+		Backend:        cfg.AudioBackend,
+		Segment:        time.Duration(cfg.SegmentMinutes) * time.Minute,
+		MeetingsDir:    cfg.MeetingsDir,
+		FolderTemplate: cfg.FolderTemplate,
+	}
+
+	switch cfg.AudioBackend {
+	case config.AudioBackendNative, "":
+		capturer, err := audio.NewSystemAudioCapturer()
+		if err != nil {
+			return nil, err
+		}
+		recorder := audio.NewRecorder()
+		record.Capturer = capturer
+		record.Recorder = recorder
+		record.Merger = recorder
+	case config.AudioBackendFile:
+		if cfg.ReplaySystem == "" || cfg.ReplayMic == "" {
+			return nil, fmt.Errorf("file audio backend requires replay_system_path and replay_mic_path")
+		}
+		record.Capturer = audio.NewFileCapturer(cfg.ReplaySystem)
+		record.Recorder = audio.NewFileMicRecorder(cfg.ReplayMic)
+		record.Merger = audio.NewWAVMixer()
+	case config.AudioBackendNull:
+		record.Capturer = audio.NewNullCapturer()
+		record.Recorder = audio.NewNullMicRecorder()
+		record.Merger = audio.NewWAVMixer()
+	default:
+		return nil, fmt.Errorf("unknown audio backend %q (expected native, file or null)", cfg.AudioBackend)
+	}
+
+	return record, nil
+}
+
+// newTranscriber returns the configured transcription provider.
+func newTranscriber(cfg *config.Config) (usecases.Transcriber, error) {
+	t := cfg.Transcription
+	switch t.Provider {
+	case config.TranscriptionMistral, "":
+		return transcriber.NewMistral(cfg.MistralAPIKey, t.BaseURL, t.Model), nil
+	case config.TranscriptionOpenAI:
+		return transcriber.NewOpenAI(t.APIKey, t.BaseURL, t.Model), nil
+	case config.TranscriptionWhisper:
+		// base_url selects server mode; otherwise the CLI is run.
+		return transcriber.NewWhisperCpp(t.Binary, t.ModelPath, t.BaseURL), nil
+	default:
+		return nil, fmt.Errorf("unknown transcription provider %q (expected mistral, openai or whispercpp)", t.Provider)
+	}
+}
+
+// languageModel is implemented by every summarization provider.
+type languageModel interface {
+	usecases.Summarizer
+	usecases.Chatter
+}
+
+// newSummarizer returns the configured summarization provider.
+func newSummarizer(cfg *config.Config) (languageModel, error) {
+	s := cfg.Summarization
+	opts := summarizer.Options{
+		Model:       s.Model,
+		MaxTokens:   s.MaxTokens,
+		Temperature: s.Temperature,
+	}
+	switch s.Provider {
+	case config.SummarizationAnthropic, "":
+		return summarizer.NewAnthropic(cfg.AnthropicKey, s.BaseURL, opts), nil
+	case config.SummarizationOpenAI:
+		return summarizer.NewOpenAI(s.APIKey, s.BaseURL, opts), nil
+	case config.SummarizationOllama:
+		return summarizer.NewOllama(s.BaseURL, opts), nil
+	default:
+		return nil, fmt.Errorf("unknown summarization provider %q (expected anthropic, openai or ollama)", s.Provider)
+	}
+}
+
+// newEmbedder returns the configured embedding provider, or nil if vector
+// search is off.
+func newEmbedder(cfg *config.Config) (usecases.Embedder, error) {
+	s := cfg.Search
+	switch s.Provider {
+	case "":
+		return nil, nil
+	case config.EmbeddingsOpenAI:
+		return embedder.NewOpenAI(s.APIKey, s.BaseURL, s.Model), nil
+	case config.EmbeddingsOllama:
+		return embedder.NewOllama(s.BaseURL, s.Model), nil
+	default:
+		return nil, fmt.Errorf("unknown search provider %q (expected openai or ollama)", s.Provider)
+	}
+}
+
+// templatePrompts maps template names to their prompts.
+func templatePrompts(templates map[string]config.SummaryTemplate) map[string]string {
+	prompts := make(map[string]string, len(templates))
+	for name, t := range templates {
+		prompts[name] = t.Prompt
+	}
+	return prompts
+}
+
+// summaryOutputs converts the configured outputs, sorted by name.
+func summaryOutputs(outputs map[string]config.SummaryOutput) []usecases.SummaryOutput {
+	var result []usecases.SummaryOutput
+	for _, name := range slices.Sorted(maps.Keys(outputs)) {
+		o := outputs[name]
+		result = append(result, usecases.SummaryOutput{Name: name, Prompt: o.Prompt, File: o.File})
+	}
+	return result
+}
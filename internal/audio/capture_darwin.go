@@ -0,0 +1,79 @@
+package audio
+
+/*
+#cgo CFLAGS: -x objective-c
+#cgo LDFLAGS: -framework ScreenCaptureKit -framework CoreMedia -framework AVFoundation -framework Foundation
+#include <stdlib.h>
+#include "capture_darwin.h"
+*/
+import "C"
+
+import (
+	"fmt"
+	"time"
+	"unsafe"
+)
+
+// SystemAudioCapturer captures system audio via ScreenCaptureKit (in-process via cgo).
+type SystemAudioCapturer struct {
+	stop chan struct{}
+	done chan struct{}
+}
+
+// NewSystemAudioCapturer returns a capturer backed by ScreenCaptureKit.
+func NewSystemAudioCapturer() (*SystemAudioCapturer, error) {
+	return &SystemAudioCapturer{}, nil
+}
+
+// StartCapture begins capturing system audio, streaming 16kHz mono WAV to outputPath.
+// With segment > 0, outputPath is a %04d pattern and a new file is started every segment.
+func (c *SystemAudioCapturer) StartCapture(outputPath string, segment time.Duration) error {
+	first := outputPath
+	if segment > 0 {
+		first = fmt.Sprintf(outputPath, 1)
+	}
+
+	cPath := C.CString(first)
+	defer C.free(unsafe.Pointer(cPath))
+
+	if C.capture_start(cPath) != 0 {
+		return fmt.Errorf("failed to start system audio capture — check screen recording permission in System Settings > Privacy & Security")
+	}
+
+	if segment > 0 {
+		c.stop = make(chan struct{})
+		c.done = make(chan struct{})
+		go c.rotate(outputPath, segment)
+	}
+	return nil
+}
+
+// StopCapture stops capturing and finalizes the WAV file.
+func (c *SystemAudioCapturer) StopCapture() {
+	if c.stop != nil {
+		close(c.stop)
+		<-c.done
+		c.stop = nil
+	}
+	C.capture_stop()
+}
+
+// rotate switches capture to the next segment file every segment until stopped.
+// If a rotation fails, capture keeps appending to the current file.
+func (c *SystemAudioCapturer) rotate(pattern string, segment time.Duration) {
+	defer close(c.done)
+
+	ticker := time.NewTicker(segment)
+	defer ticker.Stop()
+
+	for i := 2; ; i++ {
+		select {
+		case <-c.stop:
+			return
+		case <-ticker.C:
+			cPath := C.CString(fmt.Sprintf(pattern, i))
+			C.capture_rotate(cPath)
+			C.free(unsafe.Pointer(cPath))
+		}
+	}
+}
@@ -2,10 +2,15 @@
 #define CAPTURE_DARWIN_H
 
 // Start capturing system audio. Writes 16kHz mono 16-bit PCM WAV to the given path.
-// Audio is streamed to disk continuously — no ring buffer.
+// Audio is streamed to disk continuously — no ring buffer. The header is
+// updated every few seconds so the file stays valid if the process dies.
 // Returns 0 on success, -1 on error.
 int capture_start(const char *output_path);
 
+// Finalize the current file and continue capturing into output_path.
+// Returns 0 on success, -1 on error (capture continues into the old file).
+int capture_rotate(const char *output_path);
+
 // Stop capturing. Finalizes the WAV header and closes the file.
 // Returns 0 on success.
 int capture_stop(void);
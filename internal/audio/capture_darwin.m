@@ -4,8 +4,13 @@
 
 @class AudioHandler;
 
+// Rewrite the WAV header every ~5s of audio so a killed process leaves a
+// playable file behind.
+#define HEADER_UPDATE_BYTES (16000 * 2 * 5)
+
 static NSFileHandle *g_fileHandle = nil;
 static uint32_t g_dataSize = 0;
+static uint32_t g_headerDataSize = 0; // data size last written to the header
 static NSLock *g_lock = nil;
 static SCStream *g_stream = nil;
 static AudioHandler *g_handler = nil;
@@ -95,6 +100,11 @@ static void write_wav_header(NSFileHandle *fh, uint32_t dataSize) {
     @try {
         [g_fileHandle writeData:data];
         g_dataSize += (uint32_t)(outCount * sizeof(int16_t));
+        if (g_dataSize - g_headerDataSize >= HEADER_UPDATE_BYTES) {
+            write_wav_header(g_fileHandle, g_dataSize);
+            [g_fileHandle seekToEndOfFile];
+            g_headerDataSize = g_dataSize;
+        }
     } @catch (NSException *e) {}
     [g_lock unlock];
 }
@@ -104,14 +114,14 @@ static void write_wav_header(NSFileHandle *fh, uint32_t dataSize) {
 int capture_start(const char *output_path) {
     g_lock = [[NSLock alloc] init];
     g_dataSize = 0;
+    g_headerDataSize = 0;
 
     NSString *path = [NSString stringWithUTF8String:output_path];
     [[NSFileManager defaultManager] createFileAtPath:path contents:nil attributes:nil];
     g_fileHandle = [NSFileHandle fileHandleForWritingAtPath:path];
     if (!g_fileHandle) return -1;
 
-    uint8_t header[44] = {0};
-    [g_fileHandle writeData:[NSData dataWithBytes:header length:44]];
+    write_wav_header(g_fileHandle, 0);
 
     __block int result = -1;
     dispatch_semaphore_t sem = dispatch_semaphore_create(0);
@@ -174,6 +184,26 @@ int capture_start(const char *output_path) {
     return result;
 }
 
+int capture_rotate(const char *output_path) {
+    NSString *path = [NSString stringWithUTF8String:output_path];
+    [[NSFileManager defaultManager] createFileAtPath:path contents:nil attributes:nil];
+    NSFileHandle *next = [NSFileHandle fileHandleForWritingAtPath:path];
+    if (!next) return -1;
+    write_wav_header(next, 0);
+
+    [g_lock lock];
+    if (g_fileHandle) {
+        write_wav_header(g_fileHandle, g_dataSize);
+        [g_fileHandle closeFile];
+    }
+    g_fileHandle = next;
+    g_dataSize = 0;
+    g_headerDataSize = 0;
+    [g_lock unlock];
+
+    return 0;
+}
+
 int capture_stop(void) {
     if (!g_stream) return 0;
 
@@ -0,0 +1,113 @@
+package audio
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// defaultMonitorSource is the PulseAudio alias for the default sink's monitor.
+// PipeWire resolves it too when pipewire-pulse is running.
+const defaultMonitorSource = "@DEFAULT_MONITOR@"
+
+// SystemAudioCapturer captures system audio on Linux by recording the default
+// sink's monitor source through ffmpeg's pulse input.
+type SystemAudioCapturer struct {
+	cmd  *exec.Cmd
+	done chan error
+	log  *os.File
+}
+
+// NewSystemAudioCapturer returns a capturer backed by PulseAudio/PipeWire.
+func NewSystemAudioCapturer() (*SystemAudioCapturer, error) {
+	return &SystemAudioCapturer{}, nil
+}
+
+// StartCapture begins capturing system audio, streaming 16kHz mono WAV to outputPath.
+// With segment > 0, outputPath is a %04d pattern and a new file is started every segment.
+// The WAV header is kept valid while recording, as for the mic.
+func (c *SystemAudioCapturer) StartCapture(outputPath string, segment time.Duration) error {
+	args := append([]string{"-f", "pulse", "-i", monitorSource()}, ffmpegPCMArgs()...)
+	cmd := exec.Command("ffmpeg", args...)
+
+	// Log stderr for diagnostics
+	if logFile, err := os.Create(ffmpegLogPath(outputPath, "system", segment)); err == nil {
+		cmd.Stderr = logFile
+		c.log = logFile
+	}
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		c.closeLog()
+		return fmt.Errorf("failed to start system audio capture: %w", err)
+	}
+	if err := cmd.Start(); err != nil {
+		c.closeLog()
+		return fmt.Errorf("failed to start system audio capture: %w", err)
+	}
+
+	c.cmd = cmd
+	c.done = make(chan error, 1)
+	go func() {
+		err := streamWAV(stdout, outputPath, segment)
+		if err != nil {
+			// ffmpeg would block on the full pipe
+			_ = cmd.Process.Kill()
+		}
+		c.done <- errors.Join(err, cmd.Wait())
+	}()
+
+	// ffmpeg exits immediately if the pulse source can't be opened.
+	select {
+	case err := <-c.done:
+		c.cmd = nil
+		c.closeLog()
+		return fmt.Errorf("failed to start system audio capture — check that PulseAudio or pipewire-pulse is running: %v", err)
+	case <-time.After(500 * time.Millisecond):
+	}
+	return nil
+}
+
+// StopCapture stops capturing and waits for the WAV file to be finalized.
+func (c *SystemAudioCapturer) StopCapture() {
+	if c.cmd == nil {
+		return
+	}
+	defer c.closeLog()
+
+	// ffmpeg may already have received SIGINT from the terminal.
+	_ = c.cmd.Process.Signal(syscall.SIGINT)
+
+	select {
+	case <-c.done:
+	case <-time.After(5 * time.Second):
+		_ = c.cmd.Process.Kill()
+		<-c.done
+	}
+	c.cmd = nil
+}
+
+func (c *SystemAudioCapturer) closeLog() {
+	if c.log != nil {
+		c.log.Close()
+		c.log = nil
+	}
+}
+
+// monitorSource resolves the monitor source of the default sink via pactl,
+// falling back to the PulseAudio alias when pactl is unavailable.
+func monitorSource() string {
+	out, err := exec.Command("pactl", "get-default-sink").Output()
+	if err != nil {
+		return defaultMonitorSource
+	}
+	sink := strings.TrimSpace(string(out))
+	if sink == "" {
+		return defaultMonitorSource
+	}
+	return sink + ".monitor"
+}
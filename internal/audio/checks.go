@@ -0,0 +1,13 @@
+package audio
+
+// Check is the result of a platform-specific prerequisite check.
+type Check struct {
+	Name   string
+	OK     bool
+	Detail string
+}
+
+// FFmpegInstallHint returns the platform-specific command to install ffmpeg.
+func FFmpegInstallHint() string {
+	return ffmpegInstallHint
+}
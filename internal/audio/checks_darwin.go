@@ -0,0 +1,8 @@
+package audio
+
+// PlatformChecks returns the prerequisite checks specific to macOS.
+func PlatformChecks() []Check {
+	return []Check{
+		{Name: "Screen recording", OK: true, Detail: "permission will be requested on first recording"},
+	}
+}
@@ -0,0 +1,20 @@
+package audio
+
+import "os/exec"
+
+// PlatformChecks returns the prerequisite checks specific to Linux.
+func PlatformChecks() []Check {
+	if _, err := exec.LookPath("pactl"); err != nil {
+		return []Check{
+			{Name: "PulseAudio/PipeWire", OK: true, Detail: "pactl not found, system audio will use " + defaultMonitorSource},
+		}
+	}
+	if err := exec.Command("pactl", "info").Run(); err != nil {
+		return []Check{
+			{Name: "PulseAudio/PipeWire", OK: false, Detail: "no sound server reachable. Start PulseAudio or pipewire-pulse"},
+		}
+	}
+	return []Check{
+		{Name: "PulseAudio/PipeWire", OK: true, Detail: "system audio from " + monitorSource()},
+	}
+}
@@ -0,0 +1,113 @@
+package audio
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+// FileCapturer "captures" system audio by replaying an existing WAV file.
+// Useful for running the record pipeline headlessly, e.g. in CI.
+type FileCapturer struct {
+	SourcePath string
+}
+
+func NewFileCapturer(sourcePath string) *FileCapturer {
+	return &FileCapturer{SourcePath: sourcePath}
+}
+
+// StartCapture copies the source WAV to outputPath, split into segments when segment > 0.
+func (c *FileCapturer) StartCapture(outputPath string, segment time.Duration) error {
+	if err := replayFile(c.SourcePath, outputPath, segment); err != nil {
+		return fmt.Errorf("replaying system audio: %w", err)
+	}
+	return nil
+}
+
+// StopCapture is a no-op; the file is complete after StartCapture.
+func (c *FileCapturer) StopCapture() {}
+
+// FileMicRecorder "records" the mic by replaying an existing WAV file.
+// RecordMic returns as soon as the copy completes, which ends the recording.
+type FileMicRecorder struct {
+	SourcePath string
+}
+
+func NewFileMicRecorder(sourcePath string) *FileMicRecorder {
+	return &FileMicRecorder{SourcePath: sourcePath}
+}
+
+// Check verifies that the source file exists.
+func (r *FileMicRecorder) Check() error {
+	if _, err := os.Stat(r.SourcePath); err != nil {
+		return fmt.Errorf("replay mic file: %w", err)
+	}
+	return nil
+}
+
+// RecordMic copies the source WAV to outputPath, split into segments when segment > 0.
+func (r *FileMicRecorder) RecordMic(outputPath string, segment time.Duration) error {
+	if err := replayFile(r.SourcePath, outputPath, segment); err != nil {
+		return fmt.Errorf("replaying mic audio: %w", err)
+	}
+	return nil
+}
+
+// Stop is a no-op; RecordMic returns on its own.
+func (r *FileMicRecorder) Stop() {}
+
+// replayFile copies src to dst, or splits it into the numbered files of the
+// dst pattern when segment > 0.
+func replayFile(src, dst string, segment time.Duration) error {
+	if segment <= 0 {
+		return copyFile(src, dst)
+	}
+
+	in, err := OpenWAV(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	perSegment := int(segment.Seconds()) * int(in.Format.SampleRate) * int(in.Format.Channels)
+	buf := make([]int16, perSegment)
+	for i := 1; ; i++ {
+		n, err := readFull(in, buf)
+		if err != nil {
+			return err
+		}
+		if n == 0 && i > 1 {
+			return nil
+		}
+		out, err := CreateWAV(fmt.Sprintf(dst, i), in.Format)
+		if err != nil {
+			return err
+		}
+		if err := errors.Join(out.Write(buf[:n]), out.Close()); err != nil {
+			return err
+		}
+		if n < perSegment {
+			return nil
+		}
+	}
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
@@ -0,0 +1,88 @@
+package audio
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+// WAVMixer merges WAV files in pure Go, without ffmpeg. Both inputs must share
+// the same 16-bit PCM format; the shorter input is padded with silence.
+type WAVMixer struct{}
+
+func NewWAVMixer() *WAVMixer {
+	return &WAVMixer{}
+}
+
+// MergeAudio averages system and mic audio into outputPath, like ffmpeg's amix.
+func (m *WAVMixer) MergeAudio(systemPath, micPath, outputPath string) error {
+	system, err := OpenWAV(systemPath)
+	if err != nil {
+		return fmt.Errorf("opening system audio: %w", err)
+	}
+	defer system.Close()
+
+	mic, err := OpenWAV(micPath)
+	if err != nil {
+		return fmt.Errorf("opening mic audio: %w", err)
+	}
+	defer mic.Close()
+
+	if system.Format != mic.Format {
+		return fmt.Errorf("merging audio: format mismatch (%+v vs %+v)", system.Format, mic.Format)
+	}
+
+	out, err := CreateWAV(outputPath, system.Format)
+	if err != nil {
+		return fmt.Errorf("creating merged audio: %w", err)
+	}
+
+	const bufSize = 16384
+	a := make([]int16, bufSize)
+	b := make([]int16, bufSize)
+	mixed := make([]int16, bufSize)
+	for {
+		na, errA := readFull(system, a)
+		nb, errB := readFull(mic, b)
+		if errA != nil || errB != nil {
+			out.Close()
+			return fmt.Errorf("merging audio: %w", errors.Join(errA, errB))
+		}
+		n := max(na, nb)
+		if n == 0 {
+			break
+		}
+		for i := range n {
+			var sa, sb int32
+			if i < na {
+				sa = int32(a[i])
+			}
+			if i < nb {
+				sb = int32(b[i])
+			}
+			mixed[i] = int16((sa + sb) / 2)
+		}
+		if err := out.Write(mixed[:n]); err != nil {
+			out.Close()
+			return fmt.Errorf("writing merged audio: %w", err)
+		}
+	}
+
+	return out.Close()
+}
+
+// readFull reads until buf is full or the input is exhausted. EOF is not an error.
+func readFull(r *WAVReader, buf []int16) (int, error) {
+	total := 0
+	for total < len(buf) {
+		n, err := r.Read(buf[total:])
+		total += n
+		if errors.Is(err, io.EOF) {
+			return total, nil
+		}
+		if err != nil {
+			return total, err
+		}
+	}
+	return total, nil
+}
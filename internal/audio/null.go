@@ -0,0 +1,49 @@
+package audio
+
+import (
+	"fmt"
+	"time"
+)
+
+// NullCapturer captures nothing and writes an empty WAV file.
+type NullCapturer struct{}
+
+func NewNullCapturer() *NullCapturer {
+	return &NullCapturer{}
+}
+
+// StartCapture writes a header-only WAV to outputPath (the first segment when segment > 0).
+func (c *NullCapturer) StartCapture(outputPath string, segment time.Duration) error {
+	return WriteEmptyWAV(firstSegment(outputPath, segment), SpeechFormat)
+}
+
+// StopCapture is a no-op.
+func (c *NullCapturer) StopCapture() {}
+
+// NullMicRecorder records nothing. RecordMic writes an empty WAV file and
+// returns immediately, which ends the recording.
+type NullMicRecorder struct{}
+
+func NewNullMicRecorder() *NullMicRecorder {
+	return &NullMicRecorder{}
+}
+
+// Check always succeeds.
+func (r *NullMicRecorder) Check() error {
+	return nil
+}
+
+// RecordMic writes a header-only WAV to outputPath (the first segment when segment > 0).
+func (r *NullMicRecorder) RecordMic(outputPath string, segment time.Duration) error {
+	return WriteEmptyWAV(firstSegment(outputPath, segment), SpeechFormat)
+}
+
+// Stop is a no-op; RecordMic returns on its own.
+func (r *NullMicRecorder) Stop() {}
+
+func firstSegment(outputPath string, segment time.Duration) string {
+	if segment > 0 {
+		return fmt.Sprintf(outputPath, 1)
+	}
+	return outputPath
+}
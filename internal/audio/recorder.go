@@ -1,44 +1,98 @@
 package audio
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
 )
 
 // Recorder manages ffmpeg-based mic recording.
-type Recorder struct{}
+type Recorder struct {
+	mu  sync.Mutex
+	cmd *exec.Cmd
+}
 
 func NewRecorder() *Recorder {
 	return &Recorder{}
 }
 
-func (r *Recorder) CheckFFmpeg() error {
+// Check verifies that ffmpeg is installed.
+func (r *Recorder) Check() error {
 	if _, err := exec.LookPath("ffmpeg"); err != nil {
-		return fmt.Errorf("ffmpeg not found. Install with: brew install ffmpeg")
+		return fmt.Errorf("ffmpeg not found. Install with: %s", ffmpegInstallHint)
 	}
 	return nil
 }
 
 // RecordMic records from the default input device. Blocks until the process exits (e.g. SIGINT).
-func (r *Recorder) RecordMic(outputPath string) error {
-	cmd := exec.Command("ffmpeg",
-		"-f", "avfoundation",
-		"-i", ":default",
-		"-ac", "1",
-		"-ar", "16000",
-		"-y",
-		outputPath,
-	)
+// With segment > 0, outputPath is a %04d pattern and a new file is started every segment.
+// ffmpeg streams raw PCM that is written here, so the WAV header stays valid
+// while recording even if ffmpeg or meetingcli is killed.
+func (r *Recorder) RecordMic(outputPath string, segment time.Duration) error {
+	args := append(micInputArgs(), ffmpegPCMArgs()...)
+	cmd := exec.Command("ffmpeg", args...)
 
 	// Log stderr for diagnostics
-	logPath := outputPath + ".ffmpeg.log"
-	if logFile, err := os.Create(logPath); err == nil {
+	if logFile, err := os.Create(ffmpegLogPath(outputPath, "mic", segment)); err == nil {
 		cmd.Stderr = logFile
 		defer logFile.Close()
 	}
 
-	return cmd.Run()
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	r.cmd = cmd
+	r.mu.Unlock()
+
+	err = streamWAV(stdout, outputPath, segment)
+	if err != nil {
+		// ffmpeg would block on the full pipe
+		_ = cmd.Process.Kill()
+	}
+	err = errors.Join(err, cmd.Wait())
+
+	r.mu.Lock()
+	r.cmd = nil
+	r.mu.Unlock()
+	return err
+}
+
+// ffmpegPCMArgs returns the ffmpeg output arguments that stream raw 16kHz
+// mono PCM to stdout, to be written by streamWAV.
+func ffmpegPCMArgs() []string {
+	return []string{"-ac", "1", "-ar", "16000", "-f", "s16le", "pipe:1"}
+}
+
+// ffmpegLogPath returns where to write ffmpeg's stderr for outputPath. For a
+// segment pattern the log sits next to the segments as <track>.ffmpeg.log.
+func ffmpegLogPath(outputPath, track string, segment time.Duration) string {
+	if segment <= 0 {
+		return outputPath + ".ffmpeg.log"
+	}
+	dir := strings.ReplaceAll(filepath.Dir(outputPath), "%%", "%")
+	return filepath.Join(dir, track+".ffmpeg.log")
+}
+
+// Stop asks ffmpeg to finish the mic recording cleanly so it finalizes the
+// WAV header. Needed when the process is stopped by something other than a
+// terminal Ctrl+C (which reaches ffmpeg directly).
+func (r *Recorder) Stop() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.cmd != nil && r.cmd.Process != nil {
+		_ = r.cmd.Process.Signal(syscall.SIGINT)
+	}
 }
 
 // MergeAudio combines system audio and mic audio into a single mono WAV.
@@ -59,3 +113,22 @@ func (r *Recorder) MergeAudio(systemPath, micPath, outputPath string) error {
 	}
 	return nil
 }
+
+// ConvertAudio converts any ffmpeg-readable input (m4a, mp3, mp4, webm, ...)
+// into a 16kHz mono WAV.
+func (r *Recorder) ConvertAudio(inputPath, outputPath string) error {
+	cmd := exec.Command("ffmpeg",
+		"-i", inputPath,
+		"-vn",
+		"-ac", "1",
+		"-ar", "16000",
+		"-c:a", "pcm_s16le",
+		"-y",
+		outputPath,
+	)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("converting audio: %w\n%s", err, string(out))
+	}
+	return nil
+}
@@ -0,0 +1,8 @@
+package audio
+
+const ffmpegInstallHint = "brew install ffmpeg"
+
+// micInputArgs returns the ffmpeg input arguments for the default microphone.
+func micInputArgs() []string {
+	return []string{"-f", "avfoundation", "-i", ":default"}
+}
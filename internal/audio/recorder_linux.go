@@ -0,0 +1,8 @@
+package audio
+
+const ffmpegInstallHint = "install the ffmpeg package from your distribution (e.g. sudo apt install ffmpeg)"
+
+// micInputArgs returns the ffmpeg input arguments for the default PulseAudio/PipeWire source.
+func micInputArgs() []string {
+	return []string{"-f", "pulse", "-i", "default"}
+}
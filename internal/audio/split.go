@@ -0,0 +1,158 @@
+package audio
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"path/filepath"
+	"time"
+
+	"github.com/devbydaniel/meetingcli/internal/domain/meeting"
+)
+
+// energyFrame is the resolution of the silence search.
+const energyFrame = 100 * time.Millisecond
+
+// WAVSplitter cuts long recordings into overlapping chunks, placing each cut
+// at the quietest point near the target chunk length.
+type WAVSplitter struct{}
+
+func NewWAVSplitter() *WAVSplitter {
+	return &WAVSplitter{}
+}
+
+// Split writes chunks of roughly chunk length to outDir. Each chunk after the
+// first starts overlap before the previous cut. Recordings that fit in one
+// chunk are returned as a single chunk pointing at audioPath itself.
+func (s *WAVSplitter) Split(audioPath, outDir string, chunk, overlap time.Duration) ([]meeting.AudioChunk, error) {
+	r, err := OpenWAV(audioPath)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	rate := int64(r.Format.SampleRate)
+	total := r.Frames()
+	chunkFrames := durationToFrames(chunk, rate)
+	// Search for silence in the last quarter of each chunk, at most a minute.
+	window := durationToFrames(min(chunk/4, time.Minute), rate)
+
+	if chunkFrames <= 0 || total <= chunkFrames+window {
+		return []meeting.AudioChunk{{Path: audioPath, End: framesToDuration(total, rate)}}, nil
+	}
+
+	energy, err := frameEnergy(r)
+	if err != nil {
+		return nil, err
+	}
+	frameLen := durationToFrames(energyFrame, rate)
+
+	// Cut points, in frames.
+	var cuts []int64
+	for pos := int64(0); total-pos > chunkFrames+window; {
+		target := pos + chunkFrames
+		cut := quietestFrame(energy, (target-window)/frameLen, target/frameLen) * frameLen
+		if cut <= pos {
+			cut = target
+		}
+		cuts = append(cuts, cut)
+		pos = cut
+	}
+	cuts = append(cuts, total)
+
+	overlapFrames := durationToFrames(overlap, rate)
+	chunks := make([]meeting.AudioChunk, 0, len(cuts))
+	start := int64(0)
+	for i, cut := range cuts {
+		lead := int64(0)
+		if i > 0 {
+			lead = min(overlapFrames, start)
+		}
+		path := filepath.Join(outDir, fmt.Sprintf("chunk-%04d.wav", i+1))
+		if err := writeRange(r, path, start-lead, cut); err != nil {
+			return nil, err
+		}
+		chunks = append(chunks, meeting.AudioChunk{
+			Path:    path,
+			Start:   framesToDuration(start-lead, rate),
+			End:     framesToDuration(cut, rate),
+			Overlap: framesToDuration(lead, rate),
+		})
+		start = cut
+	}
+	return chunks, nil
+}
+
+// frameEnergy returns the mean absolute amplitude of every energyFrame.
+func frameEnergy(r *WAVReader) ([]float64, error) {
+	samplesPerFrame := int(durationToFrames(energyFrame, int64(r.Format.SampleRate))) * int(r.Format.Channels)
+	buf := make([]int16, samplesPerFrame)
+	var energy []float64
+	for {
+		n, err := readFull(r, buf)
+		if err != nil {
+			return nil, err
+		}
+		if n == 0 {
+			return energy, nil
+		}
+		var sum float64
+		for _, v := range buf[:n] {
+			sum += math.Abs(float64(v))
+		}
+		energy = append(energy, sum/float64(n))
+	}
+}
+
+// quietestFrame returns the index in [from, to) with the lowest energy.
+func quietestFrame(energy []float64, from, to int64) int64 {
+	from = max(from, 0)
+	to = min(to, int64(len(energy)))
+	best := to
+	for i := from; i < to; i++ {
+		if best == to || energy[i] < energy[best] {
+			best = i
+		}
+	}
+	return best
+}
+
+// writeRange copies frames [from, to) of r into a new WAV at path.
+func writeRange(r *WAVReader, path string, from, to int64) error {
+	if err := r.SeekFrame(from); err != nil {
+		return err
+	}
+	out, err := CreateWAV(path, r.Format)
+	if err != nil {
+		return err
+	}
+
+	channels := int64(r.Format.Channels)
+	remaining := (to - from) * channels
+	buf := make([]int16, 16384)
+	for remaining > 0 {
+		n, err := r.Read(buf[:min(int64(len(buf)), remaining)])
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			out.Close()
+			return err
+		}
+		if err := out.Write(buf[:n]); err != nil {
+			out.Close()
+			return err
+		}
+		remaining -= int64(n)
+	}
+	return out.Close()
+}
+
+func durationToFrames(d time.Duration, rate int64) int64 {
+	return int64(d.Seconds() * float64(rate))
+}
+
+func framesToDuration(frames, rate int64) time.Duration {
+	return time.Duration(float64(frames) / float64(rate) * float64(time.Second))
+}
@@ -0,0 +1,112 @@
+package audio
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestWAVSplitter(t *testing.T) {
+	// ramp returns d of audio where sample i is i/10+1, silent in gaps
+	// given as [from, to) sample ranges.
+	ramp := func(d time.Duration, gaps ...[2]int) []int16 {
+		samples := make([]int16, int(d.Seconds()*float64(tinyFormat.SampleRate)))
+		for i := range samples {
+			samples[i] = int16(i/10 + 1)
+		}
+		for _, g := range gaps {
+			for i := g[0]; i < g[1]; i++ {
+				samples[i] = 0
+			}
+		}
+		return samples
+	}
+	type chunk struct {
+		start, end, overlap time.Duration
+	}
+
+	tests := []struct {
+		name    string
+		samples []int16
+		chunk   time.Duration
+		overlap time.Duration
+		want    []chunk
+		single  bool // the recording itself is returned
+	}{
+		{
+			name:    "fits in one chunk",
+			samples: ramp(5 * time.Second),
+			chunk:   4 * time.Second,
+			overlap: time.Second,
+			want:    []chunk{{end: 5 * time.Second}},
+			single:  true,
+		},
+		{
+			name:    "cuts at silence",
+			samples: ramp(10*time.Second, [2]int{3500, 3600}, [2]int{7200, 7300}),
+			chunk:   4 * time.Second,
+			overlap: time.Second,
+			want: []chunk{
+				{end: 3500 * time.Millisecond},
+				{start: 2500 * time.Millisecond, end: 7200 * time.Millisecond, overlap: time.Second},
+				{start: 6200 * time.Millisecond, end: 10 * time.Second, overlap: time.Second},
+			},
+		},
+		{
+			name:    "no silence cuts at the window start",
+			samples: ramp(10 * time.Second),
+			chunk:   4 * time.Second,
+			want: []chunk{
+				{end: 3 * time.Second},
+				{start: 3 * time.Second, end: 6 * time.Second},
+				{start: 6 * time.Second, end: 10 * time.Second},
+			},
+		},
+		{
+			name:    "zero chunk length disables splitting",
+			samples: ramp(10 * time.Second),
+			want:    []chunk{{end: 10 * time.Second}},
+			single:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			audioPath := filepath.Join(dir, "recording.wav")
+			writeTestWAV(t, audioPath, tinyFormat, tt.samples)
+
+			chunks, err := NewWAVSplitter().Split(audioPath, dir, tt.chunk, tt.overlap)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(chunks) != len(tt.want) {
+				t.Fatalf("got %d chunks %+v, want %d", len(chunks), chunks, len(tt.want))
+			}
+			for i, c := range chunks {
+				want := tt.want[i]
+				if c.Start != want.start || c.End != want.end || c.Overlap != want.overlap {
+					t.Errorf("chunk %d = %v-%v (overlap %v), want %v-%v (overlap %v)",
+						i, c.Start, c.End, c.Overlap, want.start, want.end, want.overlap)
+				}
+				if tt.single {
+					if c.Path != audioPath {
+						t.Errorf("chunk path = %s, want the recording itself", c.Path)
+					}
+					continue
+				}
+
+				// Each chunk holds exactly its range of the recording.
+				from := int(c.Start.Seconds() * float64(tinyFormat.SampleRate))
+				to := int(c.End.Seconds() * float64(tinyFormat.SampleRate))
+				got := readTestWAV(t, c.Path)
+				if len(got) != to-from {
+					t.Errorf("chunk %d has %d samples, want %d", i, len(got), to-from)
+				} else if got[0] != tt.samples[from] || got[len(got)-1] != tt.samples[to-1] {
+					t.Errorf("chunk %d spans samples %d..%d, want %d..%d",
+						i, got[0], got[len(got)-1], tt.samples[from], tt.samples[to-1])
+				}
+			}
+		})
+	}
+}
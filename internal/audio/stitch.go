@@ -0,0 +1,107 @@
+package audio
+
+import (
+	"fmt"
+	"time"
+)
+
+// WAVStitcher concatenates recording segments into a single WAV in pure Go.
+type WAVStitcher struct {
+	repairer *WAVRepairer
+}
+
+func NewWAVStitcher() *WAVStitcher {
+	return &WAVStitcher{repairer: NewWAVRepairer()}
+}
+
+// Stitch concatenates segmentPaths into outputPath, repairing unfinalized
+// headers on the way. A segment that can't be read is replaced by segment's
+// worth of silence (or dropped if it is the last one), so one corrupt file
+// never loses the rest of the meeting and tracks stay aligned. Returns the
+// segments that were skipped.
+func (s *WAVStitcher) Stitch(segmentPaths []string, outputPath string, segment time.Duration) ([]string, error) {
+	var (
+		out     *WAVWriter
+		skipped []string
+		pending time.Duration // silence owed for skipped segments before the first good one
+	)
+	buf := make([]int16, 16384)
+
+	for i, path := range segmentPaths {
+		in, err := s.open(path)
+		if err != nil {
+			skipped = append(skipped, path)
+			if i < len(segmentPaths)-1 {
+				if out != nil {
+					if err := writeSilence(out, segment); err != nil {
+						out.Close()
+						return skipped, err
+					}
+				} else {
+					pending += segment
+				}
+			}
+			continue
+		}
+
+		if out == nil {
+			out, err = CreateWAV(outputPath, in.Format)
+			if err != nil {
+				in.Close()
+				return skipped, err
+			}
+			if err := writeSilence(out, pending); err != nil {
+				in.Close()
+				out.Close()
+				return skipped, err
+			}
+		} else if in.Format != out.Format {
+			in.Close()
+			out.Close()
+			return skipped, fmt.Errorf("stitching %s: format mismatch (%+v vs %+v)", path, in.Format, out.Format)
+		}
+
+		for {
+			n, err := readFull(in, buf)
+			if err != nil {
+				in.Close()
+				out.Close()
+				return skipped, fmt.Errorf("stitching %s: %w", path, err)
+			}
+			if n == 0 {
+				break
+			}
+			if err := out.Write(buf[:n]); err != nil {
+				in.Close()
+				out.Close()
+				return skipped, err
+			}
+		}
+		in.Close()
+	}
+
+	if out == nil {
+		return skipped, fmt.Errorf("no readable segments")
+	}
+	return skipped, out.Close()
+}
+
+func (s *WAVStitcher) open(path string) (*WAVReader, error) {
+	if err := s.repairer.Repair(path); err != nil {
+		return nil, err
+	}
+	return OpenWAV(path)
+}
+
+func writeSilence(out *WAVWriter, d time.Duration) error {
+	samples := int64(d.Seconds() * float64(out.Format.SampleRate) * float64(out.Format.Channels))
+	zeros := make([]int16, 16384)
+	for samples > 0 {
+		n := min(samples, int64(len(zeros)))
+		if err := out.Write(zeros[:n]); err != nil {
+			return err
+		}
+		samples -= n
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+package audio
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+)
+
+// tinyFormat keeps fixtures small: one second is 1000 samples.
+var tinyFormat = Format{SampleRate: 1000, Channels: 1, BitsPerSample: 16}
+
+// constant returns d of tinyFormat audio where every sample is value.
+func constant(d time.Duration, value int16) []int16 {
+	samples := make([]int16, int(d.Seconds()*float64(tinyFormat.SampleRate)))
+	for i := range samples {
+		samples[i] = value
+	}
+	return samples
+}
+
+func writeTestWAV(t *testing.T, path string, format Format, samples []int16) {
+	t.Helper()
+	w, err := CreateWAV(path, format)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Write(samples); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func readTestWAV(t *testing.T, path string) []int16 {
+	t.Helper()
+	r, err := OpenWAV(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	samples := make([]int16, r.Frames()*int64(r.Format.Channels))
+	n, err := readFull(r, samples)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return samples[:n]
+}
+
+func TestWAVStitcher(t *testing.T) {
+	type segment struct {
+		samples []int16
+		format  Format
+		corrupt bool // garbage instead of a WAV
+		open    bool // header never finalized
+	}
+	good := func(d time.Duration, v int16) segment { return segment{samples: constant(d, v), format: tinyFormat} }
+	corrupt := segment{corrupt: true}
+	concat := func(parts ...[]int16) []int16 { return slices.Concat(parts...) }
+
+	tests := []struct {
+		name     string
+		segments []segment
+		want     []int16
+		skipped  []int // indexes of skipped segments
+		wantErr  bool
+	}{
+		{
+			name:     "all readable",
+			segments: []segment{good(time.Second, 1), good(time.Second, 2), good(500*time.Millisecond, 3)},
+			want:     concat(constant(time.Second, 1), constant(time.Second, 2), constant(500*time.Millisecond, 3)),
+		},
+		{
+			name:     "corrupt middle becomes silence",
+			segments: []segment{good(time.Second, 1), corrupt, good(time.Second, 3)},
+			want:     concat(constant(time.Second, 1), constant(time.Second, 0), constant(time.Second, 3)),
+			skipped:  []int{1},
+		},
+		{
+			name:     "corrupt first becomes leading silence",
+			segments: []segment{corrupt, good(time.Second, 2)},
+			want:     concat(constant(time.Second, 0), constant(time.Second, 2)),
+			skipped:  []int{0},
+		},
+		{
+			name:     "corrupt last is dropped",
+			segments: []segment{good(time.Second, 1), corrupt},
+			want:     constant(time.Second, 1),
+			skipped:  []int{1},
+		},
+		{
+			name:     "unfinalized header is repaired",
+			segments: []segment{good(time.Second, 1), {samples: constant(time.Second, 2), format: tinyFormat, open: true}},
+			want:     concat(constant(time.Second, 1), constant(time.Second, 2)),
+		},
+		{
+			name:     "format mismatch",
+			segments: []segment{good(time.Second, 1), {samples: constant(time.Second, 2), format: SpeechFormat}},
+			wantErr:  true,
+		},
+		{
+			name:     "nothing readable",
+			segments: []segment{corrupt, corrupt},
+			skipped:  []int{0, 1},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			var paths []string
+			for i, s := range tt.segments {
+				path := filepath.Join(dir, fmt.Sprintf("mic-%04d.wav", i+1))
+				switch {
+				case s.corrupt:
+					if err := os.WriteFile(path, []byte("garbage"), 0o644); err != nil {
+						t.Fatal(err)
+					}
+				case s.open:
+					data := wavHeader(s.format, 0)
+					for _, v := range s.samples {
+						data = append(data, byte(v), byte(v>>8))
+					}
+					if err := os.WriteFile(path, data, 0o644); err != nil {
+						t.Fatal(err)
+					}
+				default:
+					writeTestWAV(t, path, s.format, s.samples)
+				}
+				paths = append(paths, path)
+			}
+			output := filepath.Join(dir, "mic.wav")
+
+			skipped, err := NewWAVStitcher().Stitch(paths, output, time.Second)
+
+			var wantSkipped []string
+			for _, i := range tt.skipped {
+				wantSkipped = append(wantSkipped, paths[i])
+			}
+			if !slices.Equal(skipped, wantSkipped) {
+				t.Errorf("skipped = %v, want %v", skipped, wantSkipped)
+			}
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := readTestWAV(t, output); !slices.Equal(got, tt.want) {
+				t.Errorf("stitched %d samples, want %d (first %v)", len(got), len(tt.want), got[:min(len(got), 3)])
+			}
+		})
+	}
+}
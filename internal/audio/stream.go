@@ -0,0 +1,107 @@
+package audio
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// headerInterval is how much audio is streamed between header rewrites, so a
+// killed process leaves a playable file behind (as capture_darwin.m does).
+const headerInterval = 5 * time.Second
+
+// streamWAV reads raw 16-bit little-endian PCM in SpeechFormat from r until
+// EOF and writes it to outputPath, rewriting the header every headerInterval.
+// With segment > 0, outputPath is a %04d pattern and a new file is started
+// every segment.
+func streamWAV(r io.Reader, outputPath string, segment time.Duration) error {
+	format := SpeechFormat
+	samplesPerSecond := int64(format.SampleRate) * int64(format.Channels)
+	perSync := int64(headerInterval.Seconds()) * samplesPerSecond
+	perSegment := int64(segment.Seconds()) * samplesPerSecond
+
+	var (
+		out       *WAVWriter
+		index     int
+		written   int64 // samples in the current file
+		sinceSync int64 // samples since the header was last rewritten
+	)
+	open := func() error {
+		index++
+		path := outputPath
+		if segment > 0 {
+			path = fmt.Sprintf(outputPath, index)
+		}
+		var err error
+		out, err = CreateWAV(path, format)
+		written, sinceSync = 0, 0
+		return err
+	}
+	// Like ffmpeg, create the first file up front, even if no audio arrives.
+	if err := open(); err != nil {
+		return err
+	}
+
+	raw := make([]byte, 32768)
+	samples := make([]int16, len(raw)/2)
+	pending := 0 // odd trailing byte carried over to the next read
+	for {
+		n, readErr := r.Read(raw[pending:])
+		n += pending
+		whole := n &^ 1
+		for i := range whole / 2 {
+			samples[i] = int16(binary.LittleEndian.Uint16(raw[2*i:]))
+		}
+		pending = copy(raw, raw[whole:n])
+
+		batch := samples[:whole/2]
+		for len(batch) > 0 {
+			if out == nil {
+				if err := open(); err != nil {
+					return err
+				}
+			}
+			chunk := batch
+			if perSegment > 0 && int64(len(chunk)) > perSegment-written {
+				chunk = chunk[:perSegment-written]
+			}
+			if err := out.Write(chunk); err != nil {
+				out.Close()
+				return err
+			}
+			written += int64(len(chunk))
+			sinceSync += int64(len(chunk))
+			batch = batch[len(chunk):]
+
+			if perSegment > 0 && written >= perSegment {
+				if err := out.Close(); err != nil {
+					return err
+				}
+				out = nil
+			} else if sinceSync >= perSync {
+				if err := out.Sync(); err != nil {
+					out.Close()
+					return err
+				}
+				sinceSync = 0
+			}
+		}
+
+		if errors.Is(readErr, io.EOF) {
+			break
+		}
+		if readErr != nil {
+			if out != nil {
+				out.Close()
+			}
+			return readErr
+		}
+	}
+
+	if out == nil {
+		return nil
+	}
+	return out.Close()
+}
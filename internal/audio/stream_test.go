@@ -0,0 +1,133 @@
+package audio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/iotest"
+	"time"
+)
+
+// pcm returns d of raw SpeechFormat PCM where sample i has value i%1000.
+func pcm(d time.Duration) []byte {
+	n := int(d.Seconds() * float64(SpeechFormat.SampleRate))
+	buf := make([]byte, 2*n)
+	for i := range n {
+		binary.LittleEndian.PutUint16(buf[2*i:], uint16(i%1000))
+	}
+	return buf
+}
+
+// declaredSize returns the data size in the header of the WAV at path.
+func declaredSize(t *testing.T, path string) int64 {
+	t.Helper()
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	_, _, size, err := parseWAVHeader(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return size
+}
+
+func TestStreamWAV(t *testing.T) {
+	tests := []struct {
+		name    string
+		audio   time.Duration
+		segment time.Duration
+		reader  func(io.Reader) io.Reader
+		want    []int64 // samples per file
+	}{
+		{name: "single file", audio: 7 * time.Second, want: []int64{112000}},
+		{name: "odd reads", audio: 2 * time.Second, reader: iotest.OneByteReader, want: []int64{32000}},
+		{name: "segments", audio: 2500 * time.Millisecond, segment: time.Second, want: []int64{16000, 16000, 8000}},
+		{name: "exact segments", audio: 2 * time.Second, segment: time.Second, want: []int64{16000, 16000}},
+		{name: "no audio", want: []int64{0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			outputPath := filepath.Join(dir, "mic.wav")
+			if tt.segment > 0 {
+				outputPath = filepath.Join(dir, "mic-%04d.wav")
+			}
+			var r io.Reader = bytes.NewReader(pcm(tt.audio))
+			if tt.reader != nil {
+				r = tt.reader(r)
+			}
+
+			if err := streamWAV(r, outputPath, tt.segment); err != nil {
+				t.Fatalf("streamWAV: %v", err)
+			}
+
+			matches, _ := filepath.Glob(filepath.Join(dir, "*.wav"))
+			if len(matches) != len(tt.want) {
+				t.Fatalf("got files %v, want %d", matches, len(tt.want))
+			}
+			offset := 0
+			for i, want := range tt.want {
+				path := outputPath
+				if tt.segment > 0 {
+					path = fmt.Sprintf(outputPath, i+1)
+				}
+				r, err := OpenWAV(path)
+				if err != nil {
+					t.Fatal(err)
+				}
+				samples := make([]int16, want+1)
+				n, _ := r.Read(samples)
+				r.Close()
+				if int64(n) != want || r.Frames() != want {
+					t.Errorf("%s: %d samples (%d frames), want %d", filepath.Base(path), n, r.Frames(), want)
+				}
+				if n > 0 && samples[0] != int16(offset%1000) {
+					t.Errorf("%s starts with sample %d, want %d", filepath.Base(path), samples[0], offset%1000)
+				}
+				offset += n
+			}
+		})
+	}
+}
+
+// pausingReader calls check once all of data has been read, before EOF.
+type pausingReader struct {
+	data  []byte
+	check func()
+}
+
+func (r *pausingReader) Read(p []byte) (int, error) {
+	if len(r.data) == 0 {
+		r.check()
+		return 0, io.EOF
+	}
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+	return n, nil
+}
+
+func TestStreamWAVUpdatesHeaderWhileRecording(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mic.wav")
+	r := &pausingReader{
+		data: pcm(6 * time.Second),
+		check: func() {
+			// At least the first headerInterval must be declared before Close.
+			if got, want := declaredSize(t, path), int64(5*SpeechFormat.ByteRate()); got < want {
+				t.Errorf("header declares %d bytes mid-recording, want at least %d", got, want)
+			}
+		},
+	}
+	if err := streamWAV(r, path, 0); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := declaredSize(t, path), int64(6*SpeechFormat.ByteRate()); got != want {
+		t.Errorf("final header declares %d bytes, want %d", got, want)
+	}
+}
@@ -0,0 +1,404 @@
+package audio
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+// WAVHeaderSize is the size of the canonical 44-byte PCM WAV header.
+const WAVHeaderSize = 44
+
+// Format describes a PCM WAV stream.
+type Format struct {
+	SampleRate    uint32
+	Channels      uint16
+	BitsPerSample uint16
+}
+
+// SpeechFormat is the 16kHz mono 16-bit format used for all meeting recordings.
+var SpeechFormat = Format{SampleRate: 16000, Channels: 1, BitsPerSample: 16}
+
+// ByteRate returns the number of bytes per second of audio.
+func (f Format) ByteRate() uint32 {
+	return f.SampleRate * uint32(f.Channels) * uint32(f.BitsPerSample) / 8
+}
+
+// BlockAlign returns the number of bytes per sample frame.
+func (f Format) BlockAlign() uint16 {
+	return f.Channels * f.BitsPerSample / 8
+}
+
+// WAVReader streams 16-bit PCM samples from a WAV file.
+type WAVReader struct {
+	Format Format
+	// DataSize is the number of bytes of sample data. If the header is
+	// unfinalized, it is derived from the file length.
+	DataSize int64
+
+	f          *os.File
+	r          *bufio.Reader
+	dataOffset int64
+	remaining  int64
+}
+
+// OpenWAV opens a 16-bit PCM WAV file for reading.
+func OpenWAV(path string) (*WAVReader, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	r := bufio.NewReader(f)
+	format, dataOffset, dataSize, err := parseWAVHeader(r)
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if format.BitsPerSample != 16 {
+		f.Close()
+		return nil, fmt.Errorf("%s: unsupported bit depth %d (only 16-bit PCM)", path, format.BitsPerSample)
+	}
+
+	// Unfinalized or truncated headers: trust the file length instead.
+	if avail := info.Size() - dataOffset; dataSize == 0 || dataSize > avail {
+		dataSize = avail
+	}
+	dataSize -= dataSize % int64(format.BlockAlign())
+
+	return &WAVReader{
+		Format:     format,
+		DataSize:   dataSize,
+		f:          f,
+		r:          r,
+		dataOffset: dataOffset,
+		remaining:  dataSize,
+	}, nil
+}
+
+// Frames returns the number of sample frames in the file.
+func (w *WAVReader) Frames() int64 {
+	return w.DataSize / int64(w.Format.BlockAlign())
+}
+
+// SeekFrame positions the reader at the given sample frame.
+func (w *WAVReader) SeekFrame(frame int64) error {
+	offset := min(frame*int64(w.Format.BlockAlign()), w.DataSize)
+	if _, err := w.f.Seek(w.dataOffset+offset, io.SeekStart); err != nil {
+		return err
+	}
+	w.r.Reset(w.f)
+	w.remaining = w.DataSize - offset
+	return nil
+}
+
+// Duration returns the length of the audio in seconds.
+func (w *WAVReader) Duration() float64 {
+	return float64(w.DataSize) / float64(w.Format.ByteRate())
+}
+
+// Read fills buf with interleaved samples and returns the number read.
+// It returns io.EOF when no samples remain.
+func (w *WAVReader) Read(buf []int16) (int, error) {
+	if w.remaining <= 0 {
+		return 0, io.EOF
+	}
+	n := int64(len(buf))
+	if n*2 > w.remaining {
+		n = w.remaining / 2
+	}
+	if err := binary.Read(w.r, binary.LittleEndian, buf[:n]); err != nil {
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			w.remaining = 0
+			return 0, io.EOF
+		}
+		return 0, err
+	}
+	w.remaining -= n * 2
+	return int(n), nil
+}
+
+// Close closes the underlying file.
+func (w *WAVReader) Close() error {
+	return w.f.Close()
+}
+
+// WAVWriter streams 16-bit PCM samples to a WAV file. The header is
+// written with a zero size on creation and updated on Sync and Close.
+type WAVWriter struct {
+	Format Format
+
+	f        *os.File
+	w        *bufio.Writer
+	dataSize int64
+}
+
+// CreateWAV creates a WAV file at path with the given format.
+func CreateWAV(path string, format Format) (*WAVWriter, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := f.Write(wavHeader(format, 0)); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return &WAVWriter{Format: format, f: f, w: bufio.NewWriter(f)}, nil
+}
+
+// Write appends interleaved samples.
+func (w *WAVWriter) Write(samples []int16) error {
+	if err := binary.Write(w.w, binary.LittleEndian, samples); err != nil {
+		return err
+	}
+	w.dataSize += int64(len(samples)) * 2
+	return nil
+}
+
+// Sync flushes buffered samples and rewrites the header with the current
+// size, so the file is valid even if it is never closed.
+func (w *WAVWriter) Sync() error {
+	if err := w.w.Flush(); err != nil {
+		return err
+	}
+	_, err := w.f.WriteAt(wavHeader(w.Format, w.dataSize), 0)
+	return err
+}
+
+// Close flushes buffered samples, finalizes the header and closes the file.
+func (w *WAVWriter) Close() error {
+	if err := w.Sync(); err != nil {
+		w.f.Close()
+		return err
+	}
+	return w.f.Close()
+}
+
+// WriteEmptyWAV writes a header-only WAV file with no samples.
+func WriteEmptyWAV(path string, format Format) error {
+	return os.WriteFile(path, wavHeader(format, 0), 0o644)
+}
+
+func wavHeader(format Format, dataSize int64) []byte {
+	if dataSize > 0xFFFFFFFF-36 {
+		dataSize = 0xFFFFFFFF - 36
+	}
+	h := make([]byte, WAVHeaderSize)
+	copy(h[0:4], "RIFF")
+	binary.LittleEndian.PutUint32(h[4:8], uint32(36+dataSize))
+	copy(h[8:12], "WAVE")
+	copy(h[12:16], "fmt ")
+	binary.LittleEndian.PutUint32(h[16:20], 16)
+	binary.LittleEndian.PutUint16(h[20:22], 1)
+	binary.LittleEndian.PutUint16(h[22:24], format.Channels)
+	binary.LittleEndian.PutUint32(h[24:28], format.SampleRate)
+	binary.LittleEndian.PutUint32(h[28:32], format.ByteRate())
+	binary.LittleEndian.PutUint16(h[32:34], format.BlockAlign())
+	binary.LittleEndian.PutUint16(h[34:36], format.BitsPerSample)
+	copy(h[36:40], "data")
+	binary.LittleEndian.PutUint32(h[40:44], uint32(dataSize))
+	return h
+}
+
+// parseWAVHeader reads chunks up to the start of the sample data and returns
+// the format, the data offset in the file, and the declared data size.
+func parseWAVHeader(r io.Reader) (format Format, dataOffset, dataSize int64, err error) {
+	var riff [12]byte
+	if _, err = io.ReadFull(r, riff[:]); err != nil {
+		return format, 0, 0, fmt.Errorf("reading RIFF header: %w", err)
+	}
+	if string(riff[0:4]) != "RIFF" || string(riff[8:12]) != "WAVE" {
+		return format, 0, 0, fmt.Errorf("not a WAV file")
+	}
+
+	offset := int64(12)
+	haveFormat := false
+	for {
+		var chunk [8]byte
+		if _, err = io.ReadFull(r, chunk[:]); err != nil {
+			return format, 0, 0, fmt.Errorf("reading chunk header: %w", err)
+		}
+		offset += 8
+		id := string(chunk[0:4])
+		size := int64(binary.LittleEndian.Uint32(chunk[4:8]))
+
+		switch id {
+		case "fmt ":
+			if size < 16 {
+				return format, 0, 0, fmt.Errorf("invalid fmt chunk")
+			}
+			var fmtChunk [16]byte
+			if _, err = io.ReadFull(r, fmtChunk[:]); err != nil {
+				return format, 0, 0, fmt.Errorf("reading fmt chunk: %w", err)
+			}
+			if tag := binary.LittleEndian.Uint16(fmtChunk[0:2]); tag != 1 && tag != 0xFFFE {
+				return format, 0, 0, fmt.Errorf("unsupported WAV encoding %d (only PCM)", tag)
+			}
+			format = Format{
+				Channels:      binary.LittleEndian.Uint16(fmtChunk[2:4]),
+				SampleRate:    binary.LittleEndian.Uint32(fmtChunk[4:8]),
+				BitsPerSample: binary.LittleEndian.Uint16(fmtChunk[14:16]),
+			}
+			if _, err = io.CopyN(io.Discard, r, size-16+size%2); err != nil {
+				return format, 0, 0, fmt.Errorf("reading fmt chunk: %w", err)
+			}
+			haveFormat = true
+		case "data":
+			if !haveFormat {
+				return format, 0, 0, fmt.Errorf("data chunk before fmt chunk")
+			}
+			return format, offset, size, nil
+		default:
+			if _, err = io.CopyN(io.Discard, r, size+size%2); err != nil {
+				return format, 0, 0, fmt.Errorf("skipping %q chunk: %w", id, err)
+			}
+		}
+		offset += size + size%2
+	}
+}
+
+// WAVProber reads durations from WAV headers.
+type WAVProber struct{}
+
+func NewWAVProber() *WAVProber {
+	return &WAVProber{}
+}
+
+// Duration returns the length of the WAV file at path.
+func (p *WAVProber) Duration(path string) (time.Duration, error) {
+	r, err := OpenWAV(path)
+	if err != nil {
+		return 0, err
+	}
+	defer r.Close()
+	return time.Duration(r.Duration() * float64(time.Second)), nil
+}
+
+// WAVRepairer fixes the RIFF and data chunk sizes of WAV files whose header
+// was never finalized, e.g. because the recording process was killed.
+type WAVRepairer struct{}
+
+func NewWAVRepairer() *WAVRepairer {
+	return &WAVRepairer{}
+}
+
+// NeedsRepair reports whether the header sizes of the WAV at path disagree
+// with the file length.
+func (p *WAVRepairer) NeedsRepair(path string) (bool, error) {
+	plan, err := planRepair(path)
+	if err != nil {
+		return false, err
+	}
+	return plan != nil, nil
+}
+
+// Repair rewrites the header sizes of the WAV at path from the file length.
+// Files with a zeroed placeholder header get a canonical 16kHz mono header.
+func (p *WAVRepairer) Repair(path string) error {
+	plan, err := planRepair(path)
+	if err != nil || plan == nil {
+		return err
+	}
+
+	f, err := os.OpenFile(path, os.O_WRONLY, 0)
+	if err != nil {
+		return err
+	}
+	if plan.header != nil {
+		if _, err := f.WriteAt(plan.header, 0); err != nil {
+			f.Close()
+			return err
+		}
+	} else {
+		var b [4]byte
+		binary.LittleEndian.PutUint32(b[:], plan.riffSize)
+		if _, err := f.WriteAt(b[:], 4); err != nil {
+			f.Close()
+			return err
+		}
+		binary.LittleEndian.PutUint32(b[:], plan.dataSize)
+		if _, err := f.WriteAt(b[:], plan.dataSizeOffset); err != nil {
+			f.Close()
+			return err
+		}
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// repairPlan describes the header fields to rewrite. A non-nil header
+// replaces the first 44 bytes entirely.
+type repairPlan struct {
+	header         []byte
+	riffSize       uint32
+	dataSize       uint32
+	dataSizeOffset int64
+}
+
+func planRepair(path string) (*repairPlan, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	size := info.Size()
+	if size < WAVHeaderSize {
+		return nil, fmt.Errorf("%s: too short to be a WAV file (%d bytes)", path, size)
+	}
+
+	head := make([]byte, WAVHeaderSize)
+	if _, err := io.ReadFull(f, head); err != nil {
+		return nil, err
+	}
+	if isZero(head) {
+		// Placeholder written before the first header update.
+		return &repairPlan{header: wavHeader(SpeechFormat, size-WAVHeaderSize)}, nil
+	}
+
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	format, dataOffset, declared, err := parseWAVHeader(bufio.NewReader(f))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	actual := size - dataOffset
+	actual -= actual % int64(format.BlockAlign())
+	actual = min(actual, 0xFFFFFFFF-dataOffset)
+	riffSize := uint32(min(size-8, 0xFFFFFFFF))
+	if declared == actual && binary.LittleEndian.Uint32(head[4:8]) == riffSize {
+		return nil, nil
+	}
+	return &repairPlan{
+		riffSize:       riffSize,
+		dataSize:       uint32(actual),
+		dataSizeOffset: dataOffset - 4,
+	}, nil
+}
+
+func isZero(b []byte) bool {
+	for _, c := range b {
+		if c != 0 {
+			return false
+		}
+	}
+	return true
+}
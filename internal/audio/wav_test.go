@@ -0,0 +1,192 @@
+package audio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// chunk returns a RIFF chunk with the given id and body, padded to even length.
+func chunk(id string, body []byte) []byte {
+	b := append([]byte(id), 0, 0, 0, 0)
+	binary.LittleEndian.PutUint32(b[4:], uint32(len(body)))
+	b = append(b, body...)
+	if len(body)%2 == 1 {
+		b = append(b, 0)
+	}
+	return b
+}
+
+// fmtBody returns a 16-byte fmt chunk body.
+func fmtBody(tag uint16, f Format) []byte {
+	b := make([]byte, 16)
+	binary.LittleEndian.PutUint16(b[0:], tag)
+	binary.LittleEndian.PutUint16(b[2:], f.Channels)
+	binary.LittleEndian.PutUint32(b[4:], f.SampleRate)
+	binary.LittleEndian.PutUint32(b[8:], f.ByteRate())
+	binary.LittleEndian.PutUint16(b[12:], f.BlockAlign())
+	binary.LittleEndian.PutUint16(b[14:], f.BitsPerSample)
+	return b
+}
+
+func riff(chunks ...[]byte) []byte {
+	b := []byte("RIFF\x00\x00\x00\x00WAVE")
+	for _, c := range chunks {
+		b = append(b, c...)
+	}
+	return b
+}
+
+func TestParseWAVHeader(t *testing.T) {
+	stereo := Format{SampleRate: 44100, Channels: 2, BitsPerSample: 16}
+	tests := []struct {
+		name       string
+		data       []byte
+		format     Format
+		dataOffset int64
+		dataSize   int64
+		wantErr    bool
+	}{
+		{
+			name:       "canonical",
+			data:       append(wavHeader(SpeechFormat, 64), make([]byte, 64)...),
+			format:     SpeechFormat,
+			dataOffset: 44,
+			dataSize:   64,
+		},
+		{
+			name:       "extra chunks with odd padding",
+			data:       riff(chunk("fmt ", fmtBody(1, stereo)), chunk("LIST", []byte("INFOabc")), chunk("data", make([]byte, 8))),
+			format:     stereo,
+			dataOffset: 12 + 24 + 16 + 8,
+			dataSize:   8,
+		},
+		{
+			name:       "extensible fmt",
+			data:       riff(chunk("fmt ", append(fmtBody(0xFFFE, SpeechFormat), make([]byte, 24)...)), chunk("data", nil)),
+			format:     SpeechFormat,
+			dataOffset: 12 + 48 + 8,
+		},
+		{name: "not a WAV", data: []byte("RIFF\x00\x00\x00\x00AVI LIST"), wantErr: true},
+		{name: "too short", data: []byte("RIFF"), wantErr: true},
+		{name: "data before fmt", data: riff(chunk("data", nil), chunk("fmt ", fmtBody(1, SpeechFormat))), wantErr: true},
+		{name: "not PCM", data: riff(chunk("fmt ", fmtBody(3, SpeechFormat)), chunk("data", nil)), wantErr: true},
+		{name: "short fmt", data: riff(chunk("fmt ", make([]byte, 8)), chunk("data", nil)), wantErr: true},
+		{name: "no data chunk", data: riff(chunk("fmt ", fmtBody(1, SpeechFormat))), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			format, dataOffset, dataSize, err := parseWAVHeader(bytes.NewReader(tt.data))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if format != tt.format || dataOffset != tt.dataOffset || dataSize != tt.dataSize {
+				t.Errorf("got %+v at %d (%d bytes), want %+v at %d (%d bytes)",
+					format, dataOffset, dataSize, tt.format, tt.dataOffset, tt.dataSize)
+			}
+		})
+	}
+}
+
+func TestPlanRepair(t *testing.T) {
+	withSizes := func(riffSize, dataSize uint32, samples int) []byte {
+		h := wavHeader(SpeechFormat, 0)
+		binary.LittleEndian.PutUint32(h[4:], riffSize)
+		binary.LittleEndian.PutUint32(h[40:], dataSize)
+		return append(h, make([]byte, samples*2)...)
+	}
+	tests := []struct {
+		name    string
+		data    []byte
+		want    *repairPlan
+		wantErr bool
+	}{
+		{name: "finalized", data: withSizes(36+200, 200, 100)},
+		{
+			name: "unfinalized",
+			data: withSizes(36, 0, 100),
+			want: &repairPlan{riffSize: 236, dataSize: 200, dataSizeOffset: 40},
+		},
+		{
+			name: "stale header update",
+			data: withSizes(36+100, 100, 100),
+			want: &repairPlan{riffSize: 236, dataSize: 200, dataSizeOffset: 40},
+		},
+		{
+			name: "truncated mid-sample",
+			data: append(withSizes(36, 0, 100), 0),
+			want: &repairPlan{riffSize: 237, dataSize: 200, dataSizeOffset: 40},
+		},
+		{
+			name: "zeroed placeholder",
+			data: make([]byte, 44+200),
+			want: &repairPlan{header: wavHeader(SpeechFormat, 200)},
+		},
+		{name: "too short", data: make([]byte, 20), wantErr: true},
+		{name: "garbage header", data: bytes.Repeat([]byte{1}, 100), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "track.wav")
+			if err := os.WriteFile(path, tt.data, 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			plan, err := planRepair(path)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			switch {
+			case tt.want == nil && plan != nil:
+				t.Errorf("got plan %+v, want none", plan)
+			case tt.want != nil && plan == nil:
+				t.Errorf("got no plan, want %+v", tt.want)
+			case tt.want != nil:
+				if !bytes.Equal(plan.header, tt.want.header) || plan.riffSize != tt.want.riffSize ||
+					plan.dataSize != tt.want.dataSize || plan.dataSizeOffset != tt.want.dataSizeOffset {
+					t.Errorf("got plan %+v, want %+v", plan, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestWAVRepairerRepair(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "track.wav")
+	data := append(wavHeader(SpeechFormat, 0), make([]byte, 3200)...)
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	repairer := NewWAVRepairer()
+	if err := repairer.Repair(path); err != nil {
+		t.Fatal(err)
+	}
+	if needs, err := repairer.NeedsRepair(path); err != nil || needs {
+		t.Fatalf("NeedsRepair after Repair = %v, %v", needs, err)
+	}
+	r, err := OpenWAV(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	if r.DataSize != 3200 {
+		t.Errorf("DataSize = %d, want 3200", r.DataSize)
+	}
+}
@@ -0,0 +1,113 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/devbydaniel/meetingcli/internal/output"
+)
+
+func NewActionsCmd(deps *Dependencies) *cobra.Command {
+	var all bool
+
+	cmd := &cobra.Command{
+		Use:   "actions [meeting]",
+		Short: "List open action items across meetings",
+		Long:  "List the action items extracted into each meeting's actions.json, newest meeting first. Only open items are shown unless --all is given.",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			f := output.NewFormatter(os.Stdout)
+
+			var only string
+			if len(args) == 1 {
+				dir, err := resolveMeetingDir(deps.Config.MeetingsDir, args[0])
+				if err != nil {
+					return err
+				}
+				only = dir
+			}
+
+			meetings, err := deps.App.Actions.List()
+			if err != nil {
+				return err
+			}
+
+			found := false
+			for _, ma := range meetings {
+				if only != "" && ma.Meeting.Dir != only {
+					continue
+				}
+				header := false
+				for i, item := range ma.Items {
+					if item.Done && !all {
+						continue
+					}
+					if !header {
+						f.ActionListHeader(filepath.Base(ma.Meeting.Dir))
+						header = true
+					}
+					// Numbered by position in actions.json, for `meeting actions done`.
+					f.ActionListItem(i+1, item.Task, item.Owner, item.Due, item.Timestamp, item.Done)
+					found = true
+				}
+			}
+			if !found {
+				f.Info("No open action items")
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVarP(&all, "all", "a", false, "Include completed items")
+	cmd.AddCommand(newActionsExtractCmd(deps))
+	cmd.AddCommand(newActionsDoneCmd(deps))
+	return cmd
+}
+
+func newActionsExtractCmd(deps *Dependencies) *cobra.Command {
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "extract <meeting>",
+		Short: "Extract action items from a meeting's transcript",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			meetingDir, err := resolveMeetingDir(deps.Config.MeetingsDir, args[0])
+			if err != nil {
+				return err
+			}
+			return extractActions(deps, output.NewFormatter(os.Stdout), meetingDir, force)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&force, "force", "f", false, "Re-extract if actions.json already exists (done items with the same task stay done)")
+	return cmd
+}
+
+func newActionsDoneCmd(deps *Dependencies) *cobra.Command {
+	var undo bool
+
+	cmd := &cobra.Command{
+		Use:   "done <meeting> <n>",
+		Short: "Mark an action item as done",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			meetingDir, err := resolveMeetingDir(deps.Config.MeetingsDir, args[0])
+			if err != nil {
+				return err
+			}
+			n, err := strconv.Atoi(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid item number %q", args[1])
+			}
+			return deps.App.Actions.SetDone(meetingDir, n, !undo)
+		},
+	}
+
+	cmd.Flags().BoolVar(&undo, "undo", false, "Mark the item as open again")
+	return cmd
+}
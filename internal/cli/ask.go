@@ -0,0 +1,145 @@
+package cli
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/devbydaniel/meetingcli/internal/domain/meeting"
+	"github.com/devbydaniel/meetingcli/internal/domain/meeting/usecases"
+	"github.com/devbydaniel/meetingcli/internal/output"
+)
+
+func NewAskCmd(deps *Dependencies) *cobra.Command {
+	var chat, all bool
+	var since string
+
+	cmd := &cobra.Command{
+		Use:   `ask [<meeting>] ["question"]`,
+		Short: "Ask a question about a meeting, or across meetings",
+		Long: `Ask the summarization model about a meeting. The transcript and summary
+are sent along with the question, and the answer is streamed with the
+transcript timestamps it is based on. Meetings over max_input_tokens are
+asked about with the passages most relevant to the question instead.
+
+With --all or --since, no meeting is given: the passages most relevant to
+the question are retrieved from the search index of all meetings (or those
+since the given age or date), and the sources used are listed after the
+answer.
+
+With --chat, follow-up questions are read from stdin until an empty line or
+Ctrl+D, keeping the conversation.`,
+		Args: cobra.MaximumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			all = all || since != ""
+
+			var conv *usecases.Conversation
+			if all {
+				if len(args) > 1 {
+					return fmt.Errorf("no meeting can be given with --all or --since")
+				}
+				var from time.Time
+				if since != "" {
+					t, err := parseSince(since, time.Now())
+					if err != nil {
+						return err
+					}
+					from = t
+				}
+				conv = deps.App.Ask.StartAll(from)
+			} else {
+				if len(args) == 0 {
+					return fmt.Errorf("missing meeting (or use --all)")
+				}
+				meetingDir, err := resolveMeetingDir(deps.Config.MeetingsDir, args[0])
+				if err != nil {
+					return err
+				}
+				conv, err = deps.App.Ask.Start(meetingDir)
+				if err != nil {
+					if errors.Is(err, os.ErrNotExist) {
+						return fmt.Errorf("no %s in %s: run `meeting transcribe` first", meeting.TranscriptDataFile, meetingDir)
+					}
+					return err
+				}
+				args = args[1:]
+			}
+
+			if len(args) == 0 && !chat {
+				return fmt.Errorf("missing question (or use --chat)")
+			}
+			f := output.NewFormatter(os.Stdout)
+
+			if len(args) == 1 {
+				answer, err := conv.Ask(args[0], os.Stdout)
+				if err != nil {
+					return err
+				}
+				printAnswerEnd(f, answer)
+			}
+			if !chat {
+				return nil
+			}
+
+			errs := output.NewFormatter(os.Stderr)
+			in := bufio.NewReader(os.Stdin)
+			for {
+				fmt.Print("\n> ")
+				line, err := in.ReadString('\n')
+				question := strings.TrimSpace(line)
+				if question == "" {
+					if errors.Is(err, io.EOF) {
+						fmt.Println()
+					}
+					return nil
+				}
+				if err != nil && !errors.Is(err, io.EOF) {
+					return err
+				}
+
+				answer, err := conv.Ask(question, os.Stdout)
+				if err != nil {
+					errs.Error(err.Error())
+					continue
+				}
+				printAnswerEnd(f, answer)
+			}
+		},
+	}
+
+	cmd.Flags().BoolVar(&chat, "chat", false, "Keep asking follow-up questions interactively")
+	cmd.Flags().BoolVarP(&all, "all", "a", false, "Ask across all meetings")
+	cmd.Flags().StringVar(&since, "since", "", "Ask across meetings since this age or date, e.g. 30d, 2w or 2026-02-01")
+	return cmd
+}
+
+// printAnswerEnd ends a streamed answer and lists its sources, grouped by
+// meeting.
+func printAnswerEnd(f *output.Formatter, answer *usecases.Answer) {
+	fmt.Println()
+	if len(answer.Sources) == 0 {
+		if answer.Passages > 0 {
+			f.Info("No passages cited")
+		}
+		return
+	}
+
+	var meetings []string
+	locations := make(map[string][]string)
+	for _, h := range answer.Sources {
+		if _, ok := locations[h.Meeting]; !ok {
+			meetings = append(meetings, h.Meeting)
+		}
+		locations[h.Meeting] = append(locations[h.Meeting], hitLocation(h))
+	}
+	f.SourceListHeader()
+	for _, m := range meetings {
+		f.SourceListItem(m, locations[m])
+	}
+}
@@ -6,7 +6,10 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/devbydaniel/meetingcli/config"
+	"github.com/devbydaniel/meetingcli/internal/audio"
 	"github.com/devbydaniel/meetingcli/internal/output"
+	"github.com/devbydaniel/meetingcli/internal/transcriber"
 )
 
 func NewDoctorCmd(deps *Dependencies) *cobra.Command {
@@ -18,26 +21,63 @@ func NewDoctorCmd(deps *Dependencies) *cobra.Command {
 			ok := true
 
 			if _, err := exec.LookPath("ffmpeg"); err != nil {
-				f.SetupCheck("ffmpeg", false, "not found. Install with: brew install ffmpeg")
+				f.SetupCheck("ffmpeg", false, "not found. Install with: "+audio.FFmpegInstallHint())
 				ok = false
 			} else {
 				f.SetupCheck("ffmpeg", true, "installed")
 			}
 
-			f.SetupCheck("Screen recording", true, "permission will be requested on first recording")
+			for _, c := range audio.PlatformChecks() {
+				f.SetupCheck(c.Name, c.OK, c.Detail)
+				if !c.OK {
+					ok = false
+				}
+			}
 
-			if deps.Config.MistralAPIKey != "" {
-				f.SetupCheck("Mistral API key", true, "configured")
-			} else {
-				f.SetupCheck("Mistral API key", false, "not set. Set MEETINGCLI_MISTRAL_API_KEY or add to config")
-				ok = false
+			switch deps.Config.Transcription.Provider {
+			case config.TranscriptionOpenAI:
+				detail := "OpenAI-compatible, model " + deps.App.Transcribe.Transcriber.Model()
+				if deps.Config.Transcription.BaseURL != "" {
+					detail += " at " + deps.Config.Transcription.BaseURL
+				}
+				f.SetupCheck("Transcription", true, detail)
+			case config.TranscriptionWhisper:
+				w, _ := deps.App.Transcribe.Transcriber.(*transcriber.WhisperCpp)
+				switch {
+				case w == nil:
+				case w.ServerURL != "":
+					f.SetupCheck("whisper.cpp", true, "server at "+w.ServerURL)
+				default:
+					if err := w.Check(); err != nil {
+						f.SetupCheck("whisper.cpp", false, err.Error())
+						ok = false
+					} else {
+						f.SetupCheck("whisper.cpp", true, w.Binary+", model "+w.ModelPath)
+					}
+				}
+			default:
+				if deps.Config.MistralAPIKey != "" {
+					f.SetupCheck("Mistral API key", true, "configured")
+				} else {
+					f.SetupCheck("Mistral API key", false, "not set. Set MEETINGCLI_MISTRAL_API_KEY or add to config")
+					ok = false
+				}
 			}
 
-			if deps.Config.AnthropicKey != "" {
-				f.SetupCheck("Anthropic API key", true, "configured")
-			} else {
-				f.SetupCheck("Anthropic API key", false, "not set. Set MEETINGCLI_ANTHROPIC_API_KEY or add to config")
-				ok = false
+			switch deps.Config.Summarization.Provider {
+			case config.SummarizationOpenAI, config.SummarizationOllama:
+				detail := deps.Config.Summarization.Provider + ", model " + deps.App.Summarize.Summarizer.Model()
+				if deps.Config.Summarization.BaseURL != "" {
+					detail += " at " + deps.Config.Summarization.BaseURL
+				}
+				f.SetupCheck("Summarization", true, detail)
+			default:
+				if deps.Config.AnthropicKey != "" {
+					f.SetupCheck("Anthropic API key", true, "configured")
+				} else {
+					f.SetupCheck("Anthropic API key", false, "not set. Set MEETINGCLI_ANTHROPIC_API_KEY or add to config")
+					ok = false
+				}
 			}
 
 			f.SetupCheck("Meetings directory", true, deps.Config.MeetingsDir)
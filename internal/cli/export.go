@@ -0,0 +1,37 @@
+package cli
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/devbydaniel/meetingcli/internal/domain/meeting/usecases"
+	"github.com/devbydaniel/meetingcli/internal/output"
+)
+
+func NewExportCmd(deps *Dependencies) *cobra.Command {
+	var format string
+
+	cmd := &cobra.Command{
+		Use:   "export <meeting>",
+		Short: "Export subtitles for a meeting",
+		Long:  "Write recording.srt or recording.vtt from the meeting's transcript.json, with speaker prefixes. Takes a meeting folder name, unique prefix, or path.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			meetingDir, err := resolveMeetingDir(deps.Config.MeetingsDir, args[0])
+			if err != nil {
+				return err
+			}
+
+			path, err := deps.App.Export.Execute(meetingDir, format)
+			if err != nil {
+				return err
+			}
+			output.NewFormatter(os.Stdout).ExportDone(path)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&format, "format", usecases.SubtitleSRT, "Subtitle format: srt or vtt")
+	return cmd
+}
@@ -0,0 +1,79 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/devbydaniel/meetingcli/internal/domain/meeting/usecases"
+	"github.com/devbydaniel/meetingcli/internal/output"
+)
+
+// dateLayouts are the accepted formats for --date, tried in order.
+var dateLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+func NewImportCmd(deps *Dependencies) *cobra.Command {
+	var name, date, attendees, template, tags string
+
+	cmd := &cobra.Command{
+		Use:   "import <file>",
+		Short: "Import an existing recording",
+		Long:  "Import an audio or video file (m4a, mp3, mp4, webm, ...), convert it to recording.wav, then transcribe and summarize.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			formatter := output.NewFormatter(os.Stdout)
+
+			if _, err := deps.App.Summarize.Template(template); err != nil {
+				return err
+			}
+
+			opts := &usecases.ImportOptions{
+				Name:      name,
+				Attendees: parseList(attendees),
+				Template:  template,
+				Tags:      parseList(tags),
+			}
+			if date != "" {
+				t, err := parseDate(date)
+				if err != nil {
+					return err
+				}
+				opts.StartedAt = t
+			}
+
+			formatter.Importing(args[0])
+			result, err := deps.App.Import.Execute(args[0], opts)
+			if err != nil {
+				return err
+			}
+
+			return processMeeting(deps, formatter, result)
+		},
+	}
+
+	cmd.Flags().StringVarP(&name, "name", "n", "", "Meeting name (used in folder name)")
+	cmd.Flags().StringVar(&attendees, "attendees", "", attendeesUsage)
+	cmd.Flags().StringVarP(&template, "template", "t", "", templateUsage)
+	cmd.Flags().StringVar(&tags, "tags", "", tagsUsage)
+	cmd.Flags().StringVarP(&date, "date", "d", "", `When the meeting happened, e.g. "2026-02-06 14:00" (default: now)`)
+	return cmd
+}
+
+// parseDate parses a --date value in local time.
+func parseDate(s string) (time.Time, error) {
+	for _, layout := range dateLayouts {
+		if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date %q: use YYYY-MM-DD, YYYY-MM-DD HH:MM or RFC 3339", s)
+}
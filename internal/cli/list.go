@@ -1,59 +1,154 @@
 package cli
 
 import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
 	"os"
-	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
+	"github.com/devbydaniel/meetingcli/internal/domain/meeting/usecases"
 	"github.com/devbydaniel/meetingcli/internal/output"
 )
 
+// List output formats.
+const (
+	listTable = "table"
+	listJSON  = "json"
+	listCSV   = "csv"
+)
+
 func NewListCmd(deps *Dependencies) *cobra.Command {
+	var since, until, format string
+	var filter usecases.MeetingFilter
+
 	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "List recorded meetings",
+		Long: `List meetings, newest first, with their date, duration, tags and status.
+Filters combine; --status is one of complete, missing-transcript,
+missing-summary, missing-actions or failed.`,
+		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			formatter := output.NewFormatter(os.Stdout)
+			now := time.Now()
+			if since != "" {
+				t, err := parseSince(since, now)
+				if err != nil {
+					return err
+				}
+				filter.Since = t
+			}
+			if until != "" {
+				t, err := parseUntil(until, now)
+				if err != nil {
+					return err
+				}
+				filter.Until = t
+			}
 
-			entries, err := os.ReadDir(deps.Config.MeetingsDir)
+			meetings, err := deps.App.List.Execute(filter)
 			if err != nil {
 				if os.IsNotExist(err) {
-					formatter.Info("No meetings found")
-					return nil
+					meetings = nil
+				} else {
+					return err
 				}
-				return err
 			}
 
-			// Filter to directories only
-			var dirs []os.DirEntry
-			for _, e := range entries {
-				if e.IsDir() {
-					dirs = append(dirs, e)
+			switch format {
+			case listJSON:
+				return writeMeetingsJSON(meetings)
+			case listCSV:
+				return writeMeetingsCSV(meetings)
+			case listTable:
+				formatter := output.NewFormatter(os.Stdout)
+				if len(meetings) == 0 {
+					formatter.Info("No meetings found")
+					return nil
 				}
-			}
-
-			if len(dirs) == 0 {
-				formatter.Info("No meetings found")
+				rows := make([]output.MeetingRow, len(meetings))
+				for i, m := range meetings {
+					rows[i] = output.MeetingRow{
+						Folder:    m.Folder,
+						StartedAt: m.StartedAt,
+						Duration:  m.Duration,
+						Tags:      m.Tags,
+						Status:    m.Status,
+					}
+				}
+				formatter.MeetingTable(rows)
 				return nil
+			default:
+				return fmt.Errorf("unknown output format %q (expected table, json or csv)", format)
 			}
-
-			// Sort by name (which is date-based) descending
-			sort.Slice(dirs, func(i, j int) bool {
-				return dirs[i].Name() > dirs[j].Name()
-			})
-
-			formatter.MeetingListHeader()
-			for _, d := range dirs {
-				meetingPath := deps.Config.MeetingsDir + "/" + d.Name()
-				_, transcriptErr := os.Stat(meetingPath + "/transcript.md")
-				_, summaryErr := os.Stat(meetingPath + "/summary.md")
-				formatter.MeetingListItem(d.Name(), transcriptErr == nil, summaryErr == nil)
-			}
-
-			return nil
 		},
 	}
 
+	cmd.Flags().StringVar(&since, "since", "", sinceUsage)
+	cmd.Flags().StringVar(&until, "until", "", "Only meetings before this age or date (a date includes that day)")
+	cmd.Flags().StringVar(&filter.Name, "name", "", `Only meetings whose name or folder contains this, or matches a glob such as "*standup*"`)
+	cmd.Flags().StringSliceVar(&filter.Tags, "tag", nil, "Only meetings with this tag (repeatable; all must match)")
+	cmd.Flags().StringVar(&filter.Status, "status", "", "Only meetings with this status, e.g. missing-summary")
+	cmd.Flags().StringVar(&filter.Sort, "sort", usecases.SortDate, "Sort by date (newest first), name or duration (longest first)")
+	cmd.Flags().IntVarP(&filter.Limit, "limit", "l", 0, "Maximum number of meetings (0 for all)")
+	cmd.Flags().StringVarP(&format, "output", "o", listTable, "Output format: table, json or csv")
 	return cmd
 }
+
+// meetingJSON is a meeting in `list --output json`.
+type meetingJSON struct {
+	Folder          string    `json:"folder"`
+	Path            string    `json:"path"`
+	Name            string    `json:"name,omitempty"`
+	StartedAt       time.Time `json:"started_at,omitzero"`
+	DurationSeconds float64   `json:"duration_seconds"`
+	Tags            []string  `json:"tags"`
+	Status          string    `json:"status"`
+}
+
+func writeMeetingsJSON(meetings []usecases.MeetingInfo) error {
+	items := make([]meetingJSON, len(meetings))
+	for i, m := range meetings {
+		items[i] = meetingJSON{
+			Folder:          m.Folder,
+			Path:            m.Dir,
+			Name:            m.Name,
+			StartedAt:       m.StartedAt,
+			DurationSeconds: m.Duration.Seconds(),
+			Tags:            m.Tags,
+			Status:          m.Status,
+		}
+		if items[i].Tags == nil {
+			items[i].Tags = []string{}
+		}
+	}
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(items)
+}
+
+func writeMeetingsCSV(meetings []usecases.MeetingInfo) error {
+	w := csv.NewWriter(os.Stdout)
+	_ = w.Write([]string{"folder", "path", "name", "started_at", "duration_seconds", "tags", "status"})
+	for _, m := range meetings {
+		started := ""
+		if !m.StartedAt.IsZero() {
+			started = m.StartedAt.Format(time.RFC3339)
+		}
+		_ = w.Write([]string{
+			m.Folder,
+			m.Dir,
+			m.Name,
+			started,
+			strconv.FormatFloat(m.Duration.Seconds(), 'f', 0, 64),
+			strings.Join(m.Tags, ";"),
+			m.Status,
+		})
+	}
+	w.Flush()
+	return w.Error()
+}
@@ -0,0 +1,215 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/devbydaniel/meetingcli/internal/domain/meeting"
+	"github.com/devbydaniel/meetingcli/internal/output"
+)
+
+func NewTranscribeCmd(deps *Dependencies) *cobra.Command {
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "transcribe <meeting>",
+		Short: "Transcribe an existing meeting",
+		Long:  "Re-run transcription from the meeting's recording.wav. Takes a meeting folder name, unique prefix, or path.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			meetingDir, err := resolveMeetingDir(deps.Config.MeetingsDir, args[0])
+			if err != nil {
+				return err
+			}
+			_, err = transcribeMeeting(deps, output.NewFormatter(os.Stdout), meetingDir, force)
+			return err
+		},
+	}
+
+	cmd.Flags().BoolVarP(&force, "force", "f", false, "Regenerate the transcript if it already exists")
+	return cmd
+}
+
+func NewSummarizeCmd(deps *Dependencies) *cobra.Command {
+	var force bool
+	var template string
+
+	cmd := &cobra.Command{
+		Use:   "summarize <meeting>",
+		Short: "Summarize an existing meeting",
+		Long:  "Re-run summarization from the meeting's transcript.json. Takes a meeting folder name, unique prefix, or path.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			meetingDir, err := resolveMeetingDir(deps.Config.MeetingsDir, args[0])
+			if err != nil {
+				return err
+			}
+			if template != "" {
+				if _, err := deps.App.Summarize.Template(template); err != nil {
+					return err
+				}
+				err := deps.App.Meetings.Update(meetingDir, func(m *meeting.Meeting) {
+					m.Template = template
+				})
+				if err != nil {
+					return err
+				}
+				// A different template makes any existing summary stale.
+				force = true
+			}
+			return summarizeMeeting(deps, output.NewFormatter(os.Stdout), meetingDir, force)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&force, "force", "f", false, "Regenerate the summary and outputs even if they already exist")
+	cmd.Flags().StringVarP(&template, "template", "t", "", "Summarize with this template and record it for the meeting")
+	return cmd
+}
+
+func NewProcessCmd(deps *Dependencies) *cobra.Command {
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "process <meeting>",
+		Short: "Transcribe and summarize an existing meeting",
+		Long:  "Resume a half-processed meeting: transcribe if transcript.md is missing, summarize if summary.md is missing, and extract action items if actions.json is missing.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			meetingDir, err := resolveMeetingDir(deps.Config.MeetingsDir, args[0])
+			if err != nil {
+				return err
+			}
+
+			return resumeMeeting(deps, output.NewFormatter(os.Stdout), meetingDir, force)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&force, "force", "f", false, "Regenerate outputs that already exist")
+	return cmd
+}
+
+// resumeMeeting runs the transcribe and summarize stages that have no output
+// yet (or all of them with force).
+func resumeMeeting(deps *Dependencies, formatter *output.Formatter, meetingDir string, force bool) error {
+	transcribed, err := transcribeMeeting(deps, formatter, meetingDir, force)
+	if err != nil {
+		return err
+	}
+	// A fresh transcript makes any existing summary stale.
+	if err := summarizeMeeting(deps, formatter, meetingDir, force || transcribed); err != nil {
+		return err
+	}
+	if deps.Config.ExtractActions {
+		if err := extractActions(deps, formatter, meetingDir, force || transcribed); err != nil {
+			warnActions(err)
+		}
+	}
+
+	updateSearchIndex(deps)
+	formatter.MeetingComplete(meetingDir)
+	return nil
+}
+
+// transcribeMeeting transcribes recording.wav unless a transcript already
+// exists and force is false. Reports whether a new transcript was written.
+func transcribeMeeting(deps *Dependencies, formatter *output.Formatter, meetingDir string, force bool) (bool, error) {
+	transcriptPath := filepath.Join(meetingDir, meeting.TranscriptFile)
+	if !force && fileExists(transcriptPath) {
+		formatter.Skipped(transcriptPath)
+		return false, nil
+	}
+
+	audioPath := filepath.Join(meetingDir, meeting.AudioFile)
+	if !fileExists(audioPath) {
+		return false, fmt.Errorf("no %s in %s", meeting.AudioFile, meetingDir)
+	}
+
+	formatter.Transcribing()
+	if _, err := deps.App.Transcribe.Execute(audioPath, meetingDir); err != nil {
+		return false, err
+	}
+	formatter.TranscribeDone(transcriptPath)
+	return true, nil
+}
+
+// summarizeMeeting summarizes transcript.json into summary.md and the
+// configured outputs. Without force, only the files that are missing are
+// generated.
+func summarizeMeeting(deps *Dependencies, formatter *output.Formatter, meetingDir string, force bool) error {
+	summaryPath := filepath.Join(meetingDir, meeting.SummaryFile)
+	if !force && !deps.App.Summarize.Missing(meetingDir) {
+		formatter.Skipped(summaryPath)
+		return nil
+	}
+
+	transcript, err := meeting.ReadTranscript(meetingDir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("no %s in %s: run `meeting transcribe` first", meeting.TranscriptDataFile, meetingDir)
+		}
+		return err
+	}
+
+	if !force && fileExists(summaryPath) {
+		formatter.Skipped(summaryPath)
+	}
+	formatter.Summarizing()
+	paths, err := deps.App.Summarize.Execute(transcript, meetingDir, force)
+	if err != nil {
+		return err
+	}
+	summaryDone(formatter, paths)
+	return nil
+}
+
+// summaryDone reports summary.md and the other files written by summarize.
+func summaryDone(formatter *output.Formatter, paths []string) {
+	for _, path := range paths {
+		if filepath.Base(path) == meeting.SummaryFile {
+			formatter.SummarizeDone(path)
+		} else {
+			formatter.OutputDone(path)
+		}
+	}
+}
+
+// extractActions extracts action items from transcript.json unless
+// actions.json already exists and force is false.
+func extractActions(deps *Dependencies, formatter *output.Formatter, meetingDir string, force bool) error {
+	actionsPath := filepath.Join(meetingDir, meeting.ActionsFile)
+	if !force && fileExists(actionsPath) {
+		formatter.Skipped(actionsPath)
+		return nil
+	}
+
+	transcript, err := meeting.ReadTranscript(meetingDir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("no %s in %s: run `meeting transcribe` first", meeting.TranscriptDataFile, meetingDir)
+		}
+		return err
+	}
+
+	formatter.ExtractingActions()
+	items, err := deps.App.Actions.Extract(transcript, meetingDir)
+	if err != nil {
+		return err
+	}
+	formatter.ActionsDone(actionsPath, len(items))
+	return nil
+}
+
+// warnActions reports a failed action item extraction after processing,
+// which doesn't fail the meeting.
+func warnActions(err error) {
+	fmt.Fprintf(os.Stderr, "warning: could not extract action items: %v (run `meeting actions extract` to retry)\n", err)
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
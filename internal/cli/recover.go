@@ -0,0 +1,74 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/devbydaniel/meetingcli/internal/output"
+)
+
+func NewRecoverCmd(deps *Dependencies) *cobra.Command {
+	var noProcess bool
+
+	cmd := &cobra.Command{
+		Use:   "recover [meeting]",
+		Short: "Recover interrupted recordings",
+		Long: "Find meetings whose recording was interrupted (killed process, sleep, crash), repair their WAV headers, " +
+			"merge the tracks, then transcribe and summarize. Without an argument, all interrupted meetings are recovered.",
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			formatter := output.NewFormatter(os.Stdout)
+
+			var dirs []string
+			if len(args) == 1 {
+				meetingDir, err := resolveMeetingDir(deps.Config.MeetingsDir, args[0])
+				if err != nil {
+					return err
+				}
+				dirs = append(dirs, meetingDir)
+			} else {
+				meetings, err := deps.App.Recover.Find()
+				if err != nil {
+					return err
+				}
+				for _, m := range meetings {
+					dirs = append(dirs, m.Dir)
+				}
+			}
+
+			if len(dirs) == 0 {
+				formatter.Info("No interrupted recordings found")
+				return nil
+			}
+
+			failed := 0
+			for _, dir := range dirs {
+				formatter.Recovering(filepath.Base(dir))
+				if _, err := deps.App.Recover.Execute(dir); err != nil {
+					formatter.Error(err.Error())
+					failed++
+					continue
+				}
+				if noProcess {
+					formatter.MeetingComplete(dir)
+					continue
+				}
+				if err := resumeMeeting(deps, formatter, dir, false); err != nil {
+					formatter.Error(err.Error())
+					failed++
+				}
+			}
+
+			if failed > 0 {
+				return fmt.Errorf("%d of %d meetings could not be recovered", failed, len(dirs))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&noProcess, "no-process", false, "Only repair and merge audio, skip transcription and summary")
+	return cmd
+}
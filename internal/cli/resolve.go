@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// resolveMeetingDir turns a meeting folder name, unique name prefix, or path
+// into the absolute path of the meeting directory.
+func resolveMeetingDir(meetingsDir, arg string) (string, error) {
+	if info, err := os.Stat(arg); err == nil && info.IsDir() && strings.ContainsRune(arg, filepath.Separator) {
+		return filepath.Abs(arg)
+	}
+
+	candidate := filepath.Join(meetingsDir, arg)
+	if info, err := os.Stat(candidate); err == nil && info.IsDir() {
+		return candidate, nil
+	}
+
+	entries, err := os.ReadDir(meetingsDir)
+	if err != nil {
+		return "", err
+	}
+	var matches []string
+	for _, e := range entries {
+		if e.IsDir() && strings.HasPrefix(e.Name(), arg) {
+			matches = append(matches, e.Name())
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("meeting %q not found in %s", arg, meetingsDir)
+	case 1:
+		return filepath.Join(meetingsDir, matches[0]), nil
+	default:
+		return "", fmt.Errorf("meeting %q is ambiguous: matches %s", arg, strings.Join(matches, ", "))
+	}
+}
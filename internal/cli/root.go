@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 	"time"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/devbydaniel/meetingcli/config"
 	"github.com/devbydaniel/meetingcli/internal/app"
+	"github.com/devbydaniel/meetingcli/internal/domain/meeting"
 	"github.com/devbydaniel/meetingcli/internal/domain/meeting/usecases"
 	"github.com/devbydaniel/meetingcli/internal/output"
 	"github.com/devbydaniel/meetingcli/internal/version"
@@ -20,14 +22,19 @@ type Dependencies struct {
 }
 
 func NewRootCmd(deps *Dependencies) *cobra.Command {
-	var name string
+	var name, attendees, template, tags string
 
 	rootCmd := &cobra.Command{
 		Use:   "meeting",
 		Short: "Record meetings, transcribe, and summarize",
-		Long:  "A CLI tool that records meetings, generates transcripts using Mistral Voxtral, and creates AI summaries using Claude Haiku.",
+		Long:  rootLong(deps.Config),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runRecording(deps, name)
+			return runRecording(deps, &usecases.RecordOptions{
+				Name:      name,
+				Attendees: parseList(attendees),
+				Template:  template,
+				Tags:      parseList(tags),
+			})
 		},
 	}
 
@@ -35,21 +42,42 @@ func NewRootCmd(deps *Dependencies) *cobra.Command {
 	rootCmd.SetVersionTemplate(version.Full() + "\n")
 
 	rootCmd.Flags().StringVarP(&name, "name", "n", "", "Meeting name (used in folder name)")
+	rootCmd.Flags().StringVar(&attendees, "attendees", "", attendeesUsage)
+	rootCmd.Flags().StringVarP(&template, "template", "t", "", templateUsage)
+	rootCmd.Flags().StringVar(&tags, "tags", "", tagsUsage)
 
 	rootCmd.AddCommand(NewStartCmd(deps))
+	rootCmd.AddCommand(NewImportCmd(deps))
+	rootCmd.AddCommand(NewTranscribeCmd(deps))
+	rootCmd.AddCommand(NewSummarizeCmd(deps))
+	rootCmd.AddCommand(NewProcessCmd(deps))
+	rootCmd.AddCommand(NewRecoverCmd(deps))
+	rootCmd.AddCommand(NewExportCmd(deps))
+	rootCmd.AddCommand(NewSpeakersCmd(deps))
+	rootCmd.AddCommand(NewActionsCmd(deps))
+	rootCmd.AddCommand(NewTemplatesCmd(deps))
+	rootCmd.AddCommand(NewAskCmd(deps))
+	rootCmd.AddCommand(NewSearchCmd(deps))
 	rootCmd.AddCommand(NewListCmd(deps))
+	rootCmd.AddCommand(NewShowCmd(deps))
+	rootCmd.AddCommand(NewTagCmd(deps))
 	rootCmd.AddCommand(NewDoctorCmd(deps))
 
 	return rootCmd
 }
 
 // runRecording contains the shared recording logic used by both the root command and start subcommand.
-func runRecording(deps *Dependencies, name string) error {
+func runRecording(deps *Dependencies, opts *usecases.RecordOptions) error {
 	formatter := output.NewFormatter(os.Stdout)
 
+	// Fail before recording rather than after the meeting.
+	if _, err := deps.App.Summarize.Template(opts.Template); err != nil {
+		return err
+	}
+
 	formatter.Info("Recording started. Press Ctrl+C to stop.\n")
 
-	result, err := deps.App.Record.Execute(&usecases.RecordOptions{Name: name})
+	result, err := deps.App.Record.Execute(opts)
 	if err != nil {
 		return err
 	}
@@ -57,21 +85,69 @@ func runRecording(deps *Dependencies, name string) error {
 	duration := time.Since(result.StartedAt)
 	formatter.RecordingStopped(duration)
 
+	return processMeeting(deps, formatter, result)
+}
+
+// processMeeting transcribes and summarizes a recorded or imported meeting.
+func processMeeting(deps *Dependencies, formatter *output.Formatter, result *meeting.RecordingResult) error {
 	// Transcribe
 	formatter.Transcribing()
 	transcript, err := deps.App.Transcribe.Execute(result.AudioPath, result.MeetingDir)
 	if err != nil {
 		return err
 	}
-	formatter.TranscribeDone(filepath.Join(result.MeetingDir, "transcript.md"))
+	formatter.TranscribeDone(filepath.Join(result.MeetingDir, meeting.TranscriptFile))
 
 	// Summarize
 	formatter.Summarizing()
-	if _, err := deps.App.Summarize.Execute(transcript.Text, result.MeetingDir); err != nil {
+	paths, err := deps.App.Summarize.Execute(transcript, result.MeetingDir, true)
+	if err != nil {
 		return err
 	}
-	formatter.SummarizeDone(filepath.Join(result.MeetingDir, "summary.md"))
+	summaryDone(formatter, paths)
+
+	// Extract action items. The meeting is complete without them, so a
+	// failure is a warning; `meeting actions extract` can retry.
+	if deps.Config.ExtractActions {
+		formatter.ExtractingActions()
+		items, err := deps.App.Actions.Extract(transcript, result.MeetingDir)
+		if err != nil {
+			warnActions(err)
+		} else {
+			formatter.ActionsDone(filepath.Join(result.MeetingDir, meeting.ActionsFile), len(items))
+		}
+	}
 
+	updateSearchIndex(deps)
 	formatter.MeetingComplete(result.MeetingDir)
 	return nil
 }
+
+// providerNames describe the transcription and summarization providers in
+// the root help.
+var providerNames = map[string]string{
+	config.TranscriptionMistral:   "Mistral Voxtral",
+	config.TranscriptionWhisper:   "whisper.cpp",
+	config.SummarizationAnthropic: "Claude",
+	config.SummarizationOllama:    "Ollama",
+	config.SummarizationOpenAI:    "an OpenAI-compatible API", // also TranscriptionOpenAI
+}
+
+// rootLong is the root help text, naming the configured providers.
+func rootLong(cfg *config.Config) string {
+	return fmt.Sprintf("A CLI tool that records meetings, transcribes them using %s, and creates AI summaries using %s.\n\n"+
+		"Providers and models are set in the [transcription] and [summarization] tables of config.toml.",
+		providerName(cfg.Transcription.Provider, cfg.Transcription.Model),
+		providerName(cfg.Summarization.Provider, cfg.Summarization.Model))
+}
+
+func providerName(provider, model string) string {
+	name, ok := providerNames[provider]
+	if !ok {
+		name = provider
+	}
+	if model != "" {
+		name += " (" + model + ")"
+	}
+	return name
+}
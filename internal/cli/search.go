@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/devbydaniel/meetingcli/internal/domain/meeting"
+	"github.com/devbydaniel/meetingcli/internal/domain/meeting/usecases"
+	"github.com/devbydaniel/meetingcli/internal/output"
+)
+
+func NewSearchCmd(deps *Dependencies) *cobra.Command {
+	var limit int
+	var since string
+
+	cmd := &cobra.Command{
+		Use:   `search "query"`,
+		Short: "Search the transcripts of all meetings",
+		Long: `Search transcript passages across all meetings. Results are ranked with
+BM25 full-text search, combined with vector search when [search] configures
+an embeddings provider. The index lives in .index/ in the meetings directory
+and is updated as meetings are processed and before every search.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			f := output.NewFormatter(os.Stdout)
+
+			opts := usecases.SearchOptions{Limit: limit}
+			if since != "" {
+				t, err := parseSince(since, time.Now())
+				if err != nil {
+					return err
+				}
+				opts.Since = t
+			}
+
+			hits, err := deps.App.Search.Query(strings.Join(args, " "), opts)
+			if err != nil {
+				return err
+			}
+			if len(hits) == 0 {
+				f.Info("No matches found")
+				return nil
+			}
+			for _, h := range hits {
+				f.SearchHit(h.Meeting, hitLocation(h), h.Speaker, h.Snippet)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().IntVarP(&limit, "limit", "l", 10, "Maximum number of results (0 for all)")
+	cmd.Flags().StringVar(&since, "since", "", sinceUsage)
+	return cmd
+}
+
+const sinceUsage = `Only meetings since this age or date, e.g. 30d, 2w or 2026-02-01`
+
+// hitLocation is the timestamp of a transcript hit, or "summary".
+func hitLocation(h usecases.SearchHit) string {
+	if h.Source == meeting.ChunkSummary {
+		return meeting.ChunkSummary
+	}
+	return h.Timestamp
+}
+
+// updateSearchIndex indexes newly processed meetings. Failures are warnings;
+// the index catches up on the next search.
+func updateSearchIndex(deps *Dependencies) {
+	if _, err := deps.App.Search.Update(); err != nil {
+		fmt.Fprintf(os.Stderr, "warning: could not update search index: %v\n", err)
+	}
+}
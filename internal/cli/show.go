@@ -0,0 +1,199 @@
+package cli
+
+import (
+	"bytes"
+	"cmp"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/devbydaniel/meetingcli/internal/domain/meeting"
+	"github.com/devbydaniel/meetingcli/internal/output"
+)
+
+// showFolder is the --open value without an argument.
+const showFolder = "folder"
+
+// showFiles are the artifacts --open accepts by name, besides the folder.
+var showFiles = map[string]string{
+	"summary":    meeting.SummaryFile,
+	"transcript": meeting.TranscriptFile,
+	"recording":  meeting.AudioFile,
+	"actions":    meeting.ActionsFile,
+}
+
+func NewShowCmd(deps *Dependencies) *cobra.Command {
+	var transcript, noPager, printPath bool
+	var open string
+
+	cmd := &cobra.Command{
+		Use:   "show <meeting|latest>",
+		Short: "Show a meeting's details and summary",
+		Long: `Show a meeting's metadata (date, duration, speakers, models used) and its
+summary; with --transcript also the transcript, paged through $PAGER (or
+less) on a terminal. "latest" is the most recent meeting.
+
+--path prints only the meeting folder, e.g. cd $(meeting show latest --path).
+--open opens the folder with the system's default application, or a file in
+it: --open=summary, transcript, recording or actions.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			meetingDir, err := resolveShowDir(deps, args[0])
+			if err != nil {
+				return err
+			}
+
+			if printPath {
+				fmt.Println(meetingDir)
+				return nil
+			}
+			if cmd.Flags().Changed("open") {
+				target := meetingDir
+				if open != showFolder {
+					file, ok := showFiles[open]
+					if !ok {
+						return fmt.Errorf("unknown file %q for --open (expected summary, transcript, recording or actions)", open)
+					}
+					target = filepath.Join(meetingDir, file)
+					if !fileExists(target) {
+						return fmt.Errorf("no %s in %s", file, meetingDir)
+					}
+				}
+				return openWithOS(target)
+			}
+
+			var buf bytes.Buffer
+			if err := writeShow(deps, &buf, meetingDir, transcript); err != nil {
+				return err
+			}
+			if transcript && !noPager && isTerminal(os.Stdout) {
+				return page(buf.Bytes())
+			}
+			_, err = os.Stdout.Write(buf.Bytes())
+			return err
+		},
+	}
+
+	cmd.Flags().BoolVarP(&transcript, "transcript", "t", false, "Also print the transcript")
+	cmd.Flags().BoolVar(&noPager, "no-pager", false, "Don't page the transcript")
+	cmd.Flags().BoolVar(&printPath, "path", false, "Only print the meeting folder")
+	cmd.Flags().StringVar(&open, "open", "", "Open the folder, or summary, transcript, recording or actions, with the default application")
+	cmd.Flags().Lookup("open").NoOptDefVal = showFolder
+	return cmd
+}
+
+// resolveShowDir resolves a meeting argument, where "latest" is the most
+// recent meeting unless a folder is called that.
+func resolveShowDir(deps *Dependencies, arg string) (string, error) {
+	if arg == "latest" && !fileExists(filepath.Join(deps.Config.MeetingsDir, arg)) {
+		return deps.App.List.Latest()
+	}
+	return resolveMeetingDir(deps.Config.MeetingsDir, arg)
+}
+
+// writeShow writes the details and summary of the meeting in meetingDir to
+// w, followed by the transcript if requested.
+func writeShow(deps *Dependencies, w io.Writer, meetingDir string, transcript bool) error {
+	info, err := deps.App.List.Get(meetingDir)
+	if err != nil {
+		return err
+	}
+	m, err := deps.App.Meetings.Load(meetingDir)
+	if err != nil {
+		return err
+	}
+
+	details := output.MeetingDetails{
+		Folder:             info.Folder,
+		Dir:                info.Dir,
+		Name:               info.Name,
+		StartedAt:          info.StartedAt,
+		Duration:           info.Duration,
+		Tags:               info.Tags,
+		Attendees:          m.Attendees,
+		TranscriptionModel: m.TranscriptionModel,
+		SummaryModel:       m.SummaryModel,
+		Template:           m.Template,
+		Status:             info.Status,
+	}
+	// Only diarized transcripts have speakers to list.
+	if speakers, err := deps.App.Speakers.List(meetingDir); err == nil {
+		for _, s := range speakers {
+			details.Speakers = append(details.Speakers, output.SpeakerTalk{Label: cmp.Or(s.Name, s.ID), Talk: s.Talk})
+		}
+	}
+
+	f := output.NewFormatter(w)
+	f.MeetingDetails(details)
+
+	fmt.Fprintln(w)
+	summary, err := os.ReadFile(filepath.Join(meetingDir, meeting.SummaryFile))
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		f.Info("No summary yet: run `meeting summarize`")
+	case err != nil:
+		return err
+	default:
+		fmt.Fprintln(w, strings.TrimSpace(string(summary)))
+	}
+
+	if !transcript {
+		return nil
+	}
+	fmt.Fprintln(w)
+	text, err := os.ReadFile(filepath.Join(meetingDir, meeting.TranscriptFile))
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		f.Info("No transcript yet: run `meeting transcribe`")
+	case err != nil:
+		return err
+	default:
+		fmt.Fprintln(w, strings.TrimSpace(string(text)))
+	}
+	return nil
+}
+
+// page shows content through $PAGER, or less.
+func page(content []byte) error {
+	pager := strings.Fields(cmp.Or(os.Getenv("PAGER"), "less"))
+	cmd := exec.Command(pager[0], pager[1:]...)
+	cmd.Stdin = bytes.NewReader(content)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	// Quit if it fits on one screen and don't clear it on exit.
+	cmd.Env = append(os.Environ(), "LESS="+cmp.Or(os.Getenv("LESS"), "FRX"))
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return err
+		}
+		// No pager available; print directly.
+		_, err := os.Stdout.Write(content)
+		return err
+	}
+	return nil
+}
+
+// openWithOS opens path with the system's default application.
+func openWithOS(path string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", path)
+	case "windows":
+		cmd = exec.Command("cmd", "/c", "start", "", path)
+	default:
+		cmd = exec.Command("xdg-open", path)
+	}
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("opening %s: %w", path, err)
+	}
+	return nil
+}
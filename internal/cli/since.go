@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// sinceUnits are the suffixes of relative --since values beyond those of
+// time.ParseDuration.
+var sinceUnits = map[string]time.Duration{
+	"d": 24 * time.Hour,
+	"w": 7 * 24 * time.Hour,
+}
+
+// parseSince parses a --since value: an age such as 30d, 2w or 12h before
+// now, or a date as accepted by --date.
+func parseSince(s string, now time.Time) (time.Time, error) {
+	for suffix, unit := range sinceUnits {
+		if n, err := strconv.Atoi(strings.TrimSuffix(s, suffix)); err == nil && strings.HasSuffix(s, suffix) && n >= 0 {
+			return now.Add(-time.Duration(n) * unit), nil
+		}
+	}
+	if d, err := time.ParseDuration(s); err == nil && d >= 0 {
+		return now.Add(-d), nil
+	}
+	if t, err := parseDate(s); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q: use an age such as 30d, 2w or 12h, or a date (YYYY-MM-DD)", s)
+}
+
+// parseUntil parses an --until value like parseSince, except that a date
+// without a time includes that whole day.
+func parseUntil(s string, now time.Time) (time.Time, error) {
+	t, err := parseSince(s, now)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if _, dateErr := time.Parse(time.DateOnly, s); dateErr == nil {
+		t = t.AddDate(0, 0, 1)
+	}
+	return t, nil
+}
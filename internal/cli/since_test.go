@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseSince(t *testing.T) {
+	now := time.Date(2026, 3, 15, 12, 0, 0, 0, time.Local)
+
+	tests := []struct {
+		in      string
+		want    time.Time
+		wantErr bool
+	}{
+		{in: "30d", want: now.Add(-30 * 24 * time.Hour)},
+		{in: "2w", want: now.Add(-14 * 24 * time.Hour)},
+		{in: "0d", want: now},
+		{in: "12h", want: now.Add(-12 * time.Hour)},
+		{in: "90m", want: now.Add(-90 * time.Minute)},
+		{in: "1h30m", want: now.Add(-90 * time.Minute)},
+		{in: "2026-03-01", want: time.Date(2026, 3, 1, 0, 0, 0, 0, time.Local)},
+		{in: "2026-03-01 09:30", want: time.Date(2026, 3, 1, 9, 30, 0, 0, time.Local)},
+		{in: "2026-03-01T09:30:15Z", want: time.Date(2026, 3, 1, 9, 30, 15, 0, time.UTC)},
+		{in: "-5d", wantErr: true},
+		{in: "-2h", wantErr: true},
+		{in: "d", wantErr: true},
+		{in: "3y", wantErr: true},
+		{in: "yesterday", wantErr: true},
+		{in: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := parseSince(tt.in, now)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("parseSince(%q) = %v, want error", tt.in, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseSince(%q): %v", tt.in, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("parseSince(%q) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseUntil(t *testing.T) {
+	now := time.Date(2026, 3, 15, 12, 0, 0, 0, time.Local)
+
+	tests := []struct {
+		in      string
+		want    time.Time
+		wantErr bool
+	}{
+		{in: "2026-03-01", want: time.Date(2026, 3, 2, 0, 0, 0, 0, time.Local)},
+		{in: "2026-03-01 09:30", want: time.Date(2026, 3, 1, 9, 30, 0, 0, time.Local)},
+		{in: "2026-03-01T09:30:00", want: time.Date(2026, 3, 1, 9, 30, 0, 0, time.Local)},
+		{in: "7d", want: now.Add(-7 * 24 * time.Hour)},
+		{in: "12h", want: now.Add(-12 * time.Hour)},
+		{in: "2026-13-01", wantErr: true},
+		{in: "soon", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := parseUntil(tt.in, now)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("parseUntil(%q) = %v, want error", tt.in, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseUntil(%q): %v", tt.in, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("parseUntil(%q) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/devbydaniel/meetingcli/internal/domain/meeting"
+	"github.com/devbydaniel/meetingcli/internal/output"
+)
+
+func NewSpeakersCmd(deps *Dependencies) *cobra.Command {
+	var names map[string]string
+	var summarize bool
+
+	cmd := &cobra.Command{
+		Use:   "speakers <meeting>",
+		Short: "List and name the speakers of a meeting",
+		Long: `List each diarized speaker with sample utterances and assign real names.
+Names are stored in meeting.json and transcript.md (and any subtitles) are
+re-rendered with them. Without --set, names are asked for interactively when
+stdin is a terminal.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			meetingDir, err := resolveMeetingDir(deps.Config.MeetingsDir, args[0])
+			if err != nil {
+				return err
+			}
+			f := output.NewFormatter(os.Stdout)
+
+			speakers, err := deps.App.Speakers.List(meetingDir)
+			if err != nil {
+				return err
+			}
+			for _, s := range speakers {
+				f.SpeakerListItem(s.ID, s.Name, s.Talk, s.Samples)
+			}
+
+			if len(names) == 0 {
+				if !isTerminal(os.Stdin) {
+					return nil
+				}
+				names = make(map[string]string)
+				in := bufio.NewReader(os.Stdin)
+				fmt.Println()
+				for _, s := range speakers {
+					if s.Name != "" {
+						fmt.Printf("Name for %s [%s]: ", s.ID, s.Name)
+					} else {
+						fmt.Printf("Name for %s: ", s.ID)
+					}
+					line, err := in.ReadString('\n')
+					if line = strings.TrimSpace(line); line != "" && line != s.Name {
+						names[s.ID] = line
+					}
+					if errors.Is(err, io.EOF) {
+						fmt.Println()
+						break
+					}
+					if err != nil {
+						return err
+					}
+				}
+				if len(names) == 0 {
+					return nil
+				}
+			}
+
+			if err := deps.App.Speakers.Rename(meetingDir, names); err != nil {
+				return err
+			}
+			f.TranscribeDone(filepath.Join(meetingDir, meeting.TranscriptFile))
+			updateSearchIndex(deps)
+
+			if summarize {
+				return summarizeMeeting(deps, f, meetingDir, true)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringToStringVar(&names, "set", nil, `Assign names without prompting, e.g. --set speaker_0=Alice,speaker_1=Bob (empty name removes)`)
+	cmd.Flags().BoolVar(&summarize, "summarize", false, "Regenerate the summary with the new names")
+	return cmd
+}
+
+// isTerminal reports whether f is an interactive terminal.
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
@@ -1,21 +1,50 @@
 package cli
 
 import (
+	"strings"
+
 	"github.com/spf13/cobra"
+
+	"github.com/devbydaniel/meetingcli/internal/domain/meeting/usecases"
+)
+
+const (
+	attendeesUsage = `Comma-separated attendees, e.g. "Alice,Bob" (a hint for the summarizer)`
+	templateUsage  = "Summary template (see `meeting templates list`)"
+	tagsUsage      = `Comma-separated tags, e.g. "customer,acme" (see meeting list --tag)`
 )
 
 func NewStartCmd(deps *Dependencies) *cobra.Command {
-	var name string
+	var name, attendees, template, tags string
 
 	cmd := &cobra.Command{
 		Use:   "start",
 		Short: "Record a meeting",
 		Long:  "Record mic + system audio. Press Ctrl+C to stop, then transcribe and summarize.",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runRecording(deps, name)
+			return runRecording(deps, &usecases.RecordOptions{
+				Name:      name,
+				Attendees: parseList(attendees),
+				Template:  template,
+				Tags:      parseList(tags),
+			})
 		},
 	}
 
 	cmd.Flags().StringVarP(&name, "name", "n", "", "Meeting name (used in folder name)")
+	cmd.Flags().StringVar(&attendees, "attendees", "", attendeesUsage)
+	cmd.Flags().StringVarP(&template, "template", "t", "", templateUsage)
+	cmd.Flags().StringVar(&tags, "tags", "", tagsUsage)
 	return cmd
 }
+
+// parseList splits a comma-separated flag value such as --attendees.
+func parseList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
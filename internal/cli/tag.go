@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/devbydaniel/meetingcli/internal/domain/meeting"
+	"github.com/devbydaniel/meetingcli/internal/output"
+)
+
+func NewTagCmd(deps *Dependencies) *cobra.Command {
+	var remove bool
+
+	cmd := &cobra.Command{
+		Use:   "tag <meeting> <tag>...",
+		Short: "Add or remove tags of a meeting",
+		Long:  "Add tags to a meeting (or remove them with --remove). Tags are stored in meeting.json and filter `meeting list --tag`.",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			meetingDir, err := resolveMeetingDir(deps.Config.MeetingsDir, args[0])
+			if err != nil {
+				return err
+			}
+
+			var tags []string
+			err = deps.App.Meetings.Update(meetingDir, func(m *meeting.Meeting) {
+				for _, tag := range args[1:] {
+					tag = strings.TrimSpace(tag)
+					switch {
+					case remove:
+						m.Tags = slices.DeleteFunc(m.Tags, func(t string) bool { return t == tag })
+					case tag != "" && !slices.Contains(m.Tags, tag):
+						m.Tags = append(m.Tags, tag)
+					}
+				}
+				tags = m.Tags
+			})
+			if err != nil {
+				return err
+			}
+
+			f := output.NewFormatter(os.Stdout)
+			if len(tags) == 0 {
+				f.Success("No tags")
+				return nil
+			}
+			f.Success(fmt.Sprintf("Tags: %s", strings.Join(tags, ", ")))
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&remove, "remove", false, "Remove the tags instead of adding them")
+	return cmd
+}
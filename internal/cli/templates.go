@@ -0,0 +1,44 @@
+package cli
+
+import (
+	"os"
+	"slices"
+
+	"github.com/spf13/cobra"
+
+	"github.com/devbydaniel/meetingcli/internal/output"
+)
+
+func NewTemplatesCmd(deps *Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "templates",
+		Short: "Manage summary templates",
+	}
+	cmd.AddCommand(newTemplatesListCmd(deps))
+	return cmd
+}
+
+func newTemplatesListCmd(deps *Dependencies) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List summary templates",
+		Long:  "List the summary templates from [templates.<name>] in config.toml and the templates/ directory next to it.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			f := output.NewFormatter(os.Stdout)
+
+			names := make([]string, 0, len(deps.Config.Templates))
+			for name := range deps.Config.Templates {
+				names = append(names, name)
+			}
+			slices.Sort(names)
+
+			f.Info("Summary templates:\n")
+			for _, name := range names {
+				t := deps.Config.Templates[name]
+				f.TemplateListItem(name, t.Description, t.Source)
+			}
+			return nil
+		},
+	}
+}
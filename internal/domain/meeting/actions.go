@@ -0,0 +1,52 @@
+package meeting
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// ActionItem is a task extracted from a meeting transcript.
+type ActionItem struct {
+	Task      string `json:"task"`
+	Owner     string `json:"owner,omitempty"`
+	Due       string `json:"due,omitempty"`       // YYYY-MM-DD
+	Timestamp string `json:"timestamp,omitempty"` // hh:mm:ss in the recording where it came up
+	Done      bool   `json:"done"`
+}
+
+// ReadActions loads actions.json from dir. The error wraps os.ErrNotExist
+// when the meeting has no extracted actions.
+func ReadActions(dir string) ([]ActionItem, error) {
+	path := filepath.Join(dir, ActionsFile)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var file actionsFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	return file.Items, nil
+}
+
+// WriteActions stores items as actions.json in dir.
+func WriteActions(dir string, items []ActionItem) error {
+	if items == nil {
+		items = []ActionItem{}
+	}
+	data, err := json.MarshalIndent(actionsFile{Items: items}, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := writeFileAtomic(filepath.Join(dir, ActionsFile), append(data, '\n')); err != nil {
+		return fmt.Errorf("writing %s: %w", ActionsFile, err)
+	}
+	return nil
+}
+
+type actionsFile struct {
+	Items []ActionItem `json:"items"`
+}
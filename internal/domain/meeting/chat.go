@@ -0,0 +1,13 @@
+package meeting
+
+// Roles of a ChatMessage.
+const (
+	RoleUser      = "user"
+	RoleAssistant = "assistant"
+)
+
+// ChatMessage is one turn of a conversation with a language model.
+type ChatMessage struct {
+	Role    string
+	Content string
+}
@@ -0,0 +1,83 @@
+package meeting
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// The search index lives in a dot-directory of the meetings directory, which
+// Repository.List skips.
+const (
+	IndexDir  = ".index"
+	IndexFile = "index.json"
+)
+
+// Index holds the searchable chunks of every meeting in a meetings directory.
+type Index struct {
+	Meetings map[string]*IndexEntry `json:"meetings"` // by folder name
+}
+
+// IndexEntry is the indexed state of one meeting.
+type IndexEntry struct {
+	Modified        time.Time         `json:"modified"`                   // of transcript.json when indexed
+	SummaryModified time.Time         `json:"summary_modified,omitempty"` // of summary.md; zero without one
+	Speakers        map[string]string `json:"speakers,omitempty"`
+	// EmbeddingModel produced the chunk embeddings, which are recomputed
+	// when it changes. Empty without vector search.
+	EmbeddingModel string       `json:"embedding_model,omitempty"`
+	Chunks         []IndexChunk `json:"chunks"`
+}
+
+// ChunkSummary marks index chunks taken from summary.md.
+const ChunkSummary = "summary"
+
+// IndexChunk is a passage of a transcript, or a section of the summary.
+type IndexChunk struct {
+	Source    string    `json:"source,omitempty"` // ChunkSummary, or empty for the transcript
+	Speaker   string    `json:"speaker,omitempty"`
+	Start     float64   `json:"start"` // seconds from the start of the recording
+	Text      string    `json:"text"`
+	Embedding []float32 `json:"embedding,omitempty"`
+}
+
+// ReadIndex loads the search index of meetingsDir, or returns an empty one
+// if there is none yet.
+func ReadIndex(meetingsDir string) (*Index, error) {
+	path := filepath.Join(meetingsDir, IndexDir, IndexFile)
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return &Index{Meetings: make(map[string]*IndexEntry)}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var idx Index
+	if err := json.Unmarshal(data, &idx); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	if idx.Meetings == nil {
+		idx.Meetings = make(map[string]*IndexEntry)
+	}
+	return &idx, nil
+}
+
+// WriteIndex stores idx in meetingsDir.
+func WriteIndex(meetingsDir string, idx *Index) error {
+	dir := filepath.Join(meetingsDir, IndexDir)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	data, err := json.Marshal(idx)
+	if err != nil {
+		return err
+	}
+	if err := writeFileAtomic(filepath.Join(dir, IndexFile), data); err != nil {
+		return fmt.Errorf("writing search index: %w", err)
+	}
+	return nil
+}
@@ -2,15 +2,104 @@ package meeting
 
 import "time"
 
-// Meeting represents a completed meeting with its artifacts.
+// File names of the artifacts inside a meeting folder.
+const (
+	AudioFile          = "recording.wav"
+	MicFile            = "mic.wav"
+	SystemFile         = "system.wav"
+	TranscriptFile     = "transcript.md"
+	TranscriptDataFile = "transcript.json"
+	SummaryFile        = "summary.md"
+	MetadataFile       = "meeting.json"
+	ActionsFile        = "actions.json"
+	SRTFile            = "recording.srt"
+	VTTFile            = "recording.vtt"
+)
+
+// SchemaVersion is the current version of the meeting.json format.
+const SchemaVersion = 1
+
+// Stage is a step of the meeting pipeline.
+type Stage string
+
+const (
+	StageRecord     Stage = "record"
+	StageTranscribe Stage = "transcribe"
+	StageSummarize  Stage = "summarize"
+	StageActions    Stage = "actions"
+)
+
+// Status is the state of a pipeline stage.
+type Status string
+
+const (
+	StatusRunning Status = "running"
+	StatusDone    Status = "done"
+	StatusFailed  Status = "failed"
+)
+
+// StageState records the outcome of a pipeline stage.
+type StageState struct {
+	Status    Status    `json:"status"`
+	Error     string    `json:"error,omitempty"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Meeting represents a meeting with its artifacts. It is persisted as
+// meeting.json in the meeting folder.
 type Meeting struct {
-	Name           string
-	Dir            string
-	StartedAt      time.Time
-	EndedAt        time.Time
-	AudioPath      string
-	TranscriptPath string
-	SummaryPath    string
+	SchemaVersion      int                   `json:"schema_version"`
+	Name               string                `json:"name,omitempty"`
+	StartedAt          time.Time             `json:"started_at,omitzero"`
+	EndedAt            time.Time             `json:"ended_at,omitzero"`
+	DurationSeconds    float64               `json:"duration_seconds,omitempty"`
+	AudioBackend       string                `json:"audio_backend,omitempty"`
+	TranscriptionModel string                `json:"transcription_model,omitempty"`
+	SummaryModel       string                `json:"summary_model,omitempty"`
+	Template           string                `json:"template,omitempty"`  // summary template
+	Attendees          []string              `json:"attendees,omitempty"` // given at record time, a hint for the summarizer
+	Speakers           map[string]string     `json:"speakers,omitempty"`  // diarization label (speaker_0) → name
+	Tags               []string              `json:"tags,omitempty"`      // for filtering, e.g. meeting list --tag
+	Stages             map[Stage]*StageState `json:"stages"`
+
+	// Derived from the folder on load, not persisted.
+	Dir            string `json:"-"`
+	AudioPath      string `json:"-"`
+	TranscriptPath string `json:"-"`
+	SummaryPath    string `json:"-"`
+}
+
+// Duration returns the recorded length of the meeting.
+func (m *Meeting) Duration() time.Duration {
+	return time.Duration(m.DurationSeconds * float64(time.Second))
+}
+
+// StageStatus returns the status of a stage, or "" if it never ran.
+func (m *Meeting) StageStatus(stage Stage) Status {
+	if s, ok := m.Stages[stage]; ok {
+		return s.Status
+	}
+	return ""
+}
+
+// Failed reports whether any stage failed.
+func (m *Meeting) Failed() bool {
+	for _, s := range m.Stages {
+		if s.Status == StatusFailed {
+			return true
+		}
+	}
+	return false
+}
+
+// AudioChunk is a slice of a recording cut out for separate transcription.
+type AudioChunk struct {
+	Path  string
+	Start time.Duration // offset of the chunk in the full recording
+	End   time.Duration
+	// Overlap is how much of the start of this chunk repeats the end of the
+	// previous one. The chunk is authoritative from Start+Overlap onwards.
+	Overlap time.Duration
 }
 
 // RecordingResult holds paths after a recording session completes.
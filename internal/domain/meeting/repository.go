@@ -0,0 +1,158 @@
+package meeting
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Repository reads and writes meeting.json manifests in the meetings directory.
+type Repository struct {
+	MeetingsDir string
+
+	mu sync.Mutex
+}
+
+func NewRepository(meetingsDir string) *Repository {
+	return &Repository{MeetingsDir: meetingsDir}
+}
+
+// Load reads the meeting in dir. Folders without a meeting.json (recorded by
+// older versions) get a manifest inferred from the artifacts on disk.
+func (r *Repository) Load(dir string) (*Meeting, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.load(dir)
+}
+
+// List loads every meeting folder, newest first by folder name. Dot-directories
+// such as IndexDir are skipped, as are folders whose meeting.json can't be
+// read (with a warning), so one corrupt manifest doesn't hide all meetings.
+func (r *Repository) List() ([]*Meeting, error) {
+	entries, err := os.ReadDir(r.MeetingsDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var meetings []*Meeting
+	for _, e := range entries {
+		if !e.IsDir() || strings.HasPrefix(e.Name(), ".") {
+			continue
+		}
+		m, err := r.Load(filepath.Join(r.MeetingsDir, e.Name()))
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "warning: skipping meeting %s: %v\n", e.Name(), err)
+			continue
+		}
+		meetings = append(meetings, m)
+	}
+
+	// Folder names are date-based, so name order is chronological.
+	sort.Slice(meetings, func(i, j int) bool {
+		return filepath.Base(meetings[i].Dir) > filepath.Base(meetings[j].Dir)
+	})
+	return meetings, nil
+}
+
+// Save writes m to meeting.json in m.Dir.
+func (r *Repository) Save(m *Meeting) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.save(m)
+}
+
+// Update loads the meeting in dir, applies fn and saves the result.
+func (r *Repository) Update(dir string, fn func(m *Meeting)) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	m, err := r.load(dir)
+	if err != nil {
+		return err
+	}
+	fn(m)
+	return r.save(m)
+}
+
+// StartStage marks stage as running.
+func (r *Repository) StartStage(dir string, stage Stage) error {
+	return r.Update(dir, func(m *Meeting) {
+		m.Stages[stage] = &StageState{Status: StatusRunning, UpdatedAt: time.Now()}
+	})
+}
+
+// FinishStage marks stage as done, or failed with stageErr's message.
+func (r *Repository) FinishStage(dir string, stage Stage, stageErr error) error {
+	return r.Update(dir, func(m *Meeting) {
+		state := &StageState{Status: StatusDone, UpdatedAt: time.Now()}
+		if stageErr != nil {
+			state.Status = StatusFailed
+			state.Error = stageErr.Error()
+		}
+		m.Stages[stage] = state
+	})
+}
+
+func (r *Repository) load(dir string) (*Meeting, error) {
+	m := &Meeting{}
+	data, err := os.ReadFile(filepath.Join(dir, MetadataFile))
+	switch {
+	case err == nil:
+		if err := json.Unmarshal(data, m); err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", filepath.Join(dir, MetadataFile), err)
+		}
+		if m.SchemaVersion > SchemaVersion {
+			return nil, fmt.Errorf("%s has schema version %d, newer than supported %d", filepath.Join(dir, MetadataFile), m.SchemaVersion, SchemaVersion)
+		}
+	case errors.Is(err, os.ErrNotExist):
+		m = inferMeeting(dir)
+	default:
+		return nil, err
+	}
+
+	if m.Stages == nil {
+		m.Stages = make(map[Stage]*StageState)
+	}
+	m.SchemaVersion = SchemaVersion
+	m.Dir = dir
+	m.AudioPath = filepath.Join(dir, AudioFile)
+	m.TranscriptPath = filepath.Join(dir, TranscriptFile)
+	m.SummaryPath = filepath.Join(dir, SummaryFile)
+	return m, nil
+}
+
+func (r *Repository) save(m *Meeting) error {
+	m.SchemaVersion = SchemaVersion
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := writeFileAtomic(filepath.Join(m.Dir, MetadataFile), append(data, '\n')); err != nil {
+		return fmt.Errorf("writing %s: %w", MetadataFile, err)
+	}
+	return nil
+}
+
+// inferMeeting builds a manifest for a folder that has none, marking stages
+// done when their output file exists.
+func inferMeeting(dir string) *Meeting {
+	m := &Meeting{Stages: make(map[Stage]*StageState)}
+	for stage, file := range map[Stage]string{
+		StageRecord:     AudioFile,
+		StageTranscribe: TranscriptFile,
+		StageSummarize:  SummaryFile,
+		StageActions:    ActionsFile,
+	} {
+		if info, err := os.Stat(filepath.Join(dir, file)); err == nil {
+			m.Stages[stage] = &StageState{Status: StatusDone, UpdatedAt: info.ModTime()}
+		}
+	}
+	return m
+}
@@ -0,0 +1,52 @@
+package meeting
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Track names, used as file name prefixes for recording segments.
+const (
+	TrackMic    = "mic"
+	TrackSystem = "system"
+)
+
+// SegmentPattern returns the printf-style pattern for a track's segment files
+// in dir (e.g. mic-0001.wav). Numbering starts at 1. Literal % characters in
+// dir are escaped, so the pattern is safe for both fmt and ffmpeg.
+func SegmentPattern(dir, track string) string {
+	return filepath.Join(strings.ReplaceAll(dir, "%", "%%"), track+"-%04d.wav")
+}
+
+// SegmentFiles returns the segment files of a track in dir, in recording order.
+func SegmentFiles(dir, track string) []string {
+	// Not filepath.Glob: dir comes from the meeting name and may contain
+	// pattern characters such as [.
+	entries, _ := os.ReadDir(dir)
+
+	type segment struct {
+		path  string
+		index int
+	}
+	var segments []segment
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasPrefix(name, track+"-") || !strings.HasSuffix(name, ".wav") {
+			continue
+		}
+		num := strings.TrimSuffix(strings.TrimPrefix(name, track+"-"), ".wav")
+		if index, err := strconv.Atoi(num); err == nil {
+			segments = append(segments, segment{path: filepath.Join(dir, name), index: index})
+		}
+	}
+	sort.Slice(segments, func(i, j int) bool { return segments[i].index < segments[j].index })
+
+	paths := make([]string, len(segments))
+	for i, s := range segments {
+		paths[i] = s.path
+	}
+	return paths
+}
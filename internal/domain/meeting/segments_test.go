@@ -0,0 +1,56 @@
+package meeting
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestSegmentFiles(t *testing.T) {
+	tests := []struct {
+		name   string
+		folder string
+	}{
+		{name: "plain folder", folder: "2026-03-02_09-00-00_sync"},
+		{name: "bracketed folder", folder: "2026-03-02_09-00-00_[ACME] sync"},
+		{name: "glob characters", folder: "sync*?"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := filepath.Join(t.TempDir(), tt.folder)
+			if err := os.Mkdir(dir, 0o755); err != nil {
+				t.Fatal(err)
+			}
+			for _, name := range []string{
+				"mic-0010.wav", "mic-0002.wav", "mic-0001.wav",
+				"mic-0003.wav.broken", "mic-x.wav", "mic.wav",
+				"system-0001.wav", "recording.wav",
+			} {
+				if err := os.WriteFile(filepath.Join(dir, name), nil, 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err := os.Mkdir(filepath.Join(dir, "mic-0004.wav"), 0o755); err != nil {
+				t.Fatal(err)
+			}
+
+			want := []string{
+				filepath.Join(dir, "mic-0001.wav"),
+				filepath.Join(dir, "mic-0002.wav"),
+				filepath.Join(dir, "mic-0010.wav"),
+			}
+			if got := SegmentFiles(dir, TrackMic); !slices.Equal(got, want) {
+				t.Errorf("mic segments = %v, want %v", got, want)
+			}
+			if got := SegmentFiles(dir, TrackSystem); !slices.Equal(got, []string{filepath.Join(dir, "system-0001.wav")}) {
+				t.Errorf("system segments = %v", got)
+			}
+		})
+	}
+
+	if got := SegmentFiles(filepath.Join(t.TempDir(), "missing"), TrackMic); len(got) != 0 {
+		t.Errorf("segments of a missing dir = %v, want none", got)
+	}
+}
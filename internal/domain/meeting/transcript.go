@@ -0,0 +1,99 @@
+package meeting
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Transcript is the result of transcribing a recording. It is stored as
+// transcript.json, the source every later step reads from; transcript.md is
+// only a rendering of it.
+type Transcript struct {
+	Text     string              `json:"text"`
+	Segments []TranscriptSegment `json:"segments"`
+}
+
+// TranscriptSegment is a span of speech, diarized when the provider supports
+// it. Start and End are seconds from the start of the recording. Confidence
+// is between 0 and 1, or 0 when the provider doesn't report one.
+type TranscriptSegment struct {
+	Speaker    string  `json:"speaker"`
+	Text       string  `json:"text"`
+	Start      float64 `json:"start"`
+	End        float64 `json:"end"`
+	Confidence float64 `json:"confidence,omitempty"`
+}
+
+// WithSpeakerNames returns a copy of t with diarization labels replaced by
+// their assigned names. Labels without a name are kept.
+func (t *Transcript) WithSpeakerNames(names map[string]string) *Transcript {
+	if len(names) == 0 {
+		return t
+	}
+	named := &Transcript{Text: t.Text, Segments: make([]TranscriptSegment, len(t.Segments))}
+	for i, seg := range t.Segments {
+		if name := names[seg.Speaker]; name != "" {
+			seg.Speaker = name
+		}
+		named.Segments[i] = seg
+	}
+	return named
+}
+
+// transcriptHeading is the title older versions wrote at the top of transcript.md.
+const transcriptHeading = "# Meeting Transcript"
+
+// ReadTranscript loads transcript.json from dir. Meetings transcribed before
+// transcript.json existed fall back to the text of transcript.md, without
+// segments or its heading, so re-rendering doesn't repeat it. The error wraps
+// os.ErrNotExist when neither file exists.
+func ReadTranscript(dir string) (*Transcript, error) {
+	path := filepath.Join(dir, TranscriptDataFile)
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		md, mdErr := os.ReadFile(filepath.Join(dir, TranscriptFile))
+		if mdErr != nil {
+			return nil, err
+		}
+		text := strings.TrimSpace(string(md))
+		if first, rest, _ := strings.Cut(text, "\n"); strings.TrimSpace(first) == transcriptHeading {
+			text = strings.TrimSpace(rest)
+		}
+		return &Transcript{Text: text}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	t := &Transcript{}
+	if err := json.Unmarshal(data, t); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	return t, nil
+}
+
+// WriteTranscript stores t as transcript.json in dir.
+func WriteTranscript(dir string, t *Transcript) error {
+	data, err := json.MarshalIndent(t, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := writeFileAtomic(filepath.Join(dir, TranscriptDataFile), append(data, '\n')); err != nil {
+		return fmt.Errorf("writing %s: %w", TranscriptDataFile, err)
+	}
+	return nil
+}
+
+// writeFileAtomic writes to a temp file and renames it over path, so a crash
+// never leaves a torn file.
+func writeFileAtomic(path string, data []byte) error {
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
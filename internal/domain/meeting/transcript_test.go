@@ -0,0 +1,36 @@
+package meeting
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestReadTranscriptMarkdownFallback(t *testing.T) {
+	tests := []struct {
+		name string
+		md   string
+		want string
+	}{
+		{name: "heading is stripped", md: "# Meeting Transcript\n\nHello everyone.\n", want: "Hello everyone."},
+		{name: "no heading", md: "Hello everyone.\n", want: "Hello everyone."},
+		{name: "other headings are kept", md: "# Standup\n\nHello.\n", want: "# Standup\n\nHello."},
+		{name: "only the heading", md: "# Meeting Transcript\n", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			if err := os.WriteFile(filepath.Join(dir, TranscriptFile), []byte(tt.md), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			got, err := ReadTranscript(dir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Text != tt.want || got.Segments != nil {
+				t.Errorf("got %q (%d segments), want %q", got.Text, len(got.Segments), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,257 @@
+package usecases
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/devbydaniel/meetingcli/internal/domain/meeting"
+)
+
+// actionsSchema is the JSON Schema the model's reply must match. It is sent
+// with the prompt and enforced by parseActions.
+const actionsSchema = `{
+  "type": "object",
+  "required": ["items"],
+  "additionalProperties": false,
+  "properties": {
+    "items": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "required": ["task"],
+        "additionalProperties": false,
+        "properties": {
+          "task": {"type": "string", "minLength": 1, "description": "what has to be done, as an imperative sentence"},
+          "owner": {"type": "string", "description": "who is responsible, if identifiable"},
+          "due": {"type": "string", "pattern": "^\\d{4}-\\d{2}-\\d{2}$", "description": "due date if one was mentioned"},
+          "timestamp": {"type": "string", "pattern": "^\\d{2}:\\d{2}:\\d{2}$", "description": "transcript timestamp where the item came up"}
+        }
+      }
+    }
+  }
+}`
+
+const actionsSystemPrompt = `You extract action items from meeting transcripts: concrete tasks someone agreed or was asked to do. Ignore general discussion. Reply with only a JSON object, no prose or code fences, matching this JSON Schema:
+
+` + actionsSchema
+
+var (
+	dueDatePattern   = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+	timestampPattern = regexp.MustCompile(`^\d{2}:\d{2}:\d{2}$`)
+)
+
+// Actions extracts structured action items into actions.json and tracks
+// their completion.
+type Actions struct {
+	Summarizer Summarizer
+	Meetings   *meeting.Repository
+	// Transcripts estimated at more than TokenBudget tokens are split into
+	// parts whose action items are extracted one after another. Zero sends
+	// every transcript at once.
+	TokenBudget int
+}
+
+// MeetingActions are the action items of one meeting, in actions.json order.
+type MeetingActions struct {
+	Meeting *meeting.Meeting
+	Items   []meeting.ActionItem
+}
+
+// Extract asks the model for the action items in transcript and writes
+// actions.json. An invalid reply is retried once with the validation error.
+// Transcripts over TokenBudget are sent in parts. When actions.json already
+// exists, items whose task matches one marked done stay done.
+func (a *Actions) Extract(transcript *meeting.Transcript, meetingDir string) ([]meeting.ActionItem, error) {
+	var items []meeting.ActionItem
+	err := trackStage(a.Meetings, meetingDir, meeting.StageActions, func() error {
+		var err error
+		items, err = a.extract(transcript, meetingDir)
+		return err
+	})
+	return items, err
+}
+
+func (a *Actions) extract(transcript *meeting.Transcript, meetingDir string) ([]meeting.ActionItem, error) {
+	m := loadMeeting(a.Meetings, meetingDir)
+	var intro string
+	if !m.StartedAt.IsZero() {
+		intro = fmt.Sprintf("The meeting took place on %s; resolve relative due dates against it.\n\n", m.StartedAt.Format("Monday, 2006-01-02"))
+	}
+
+	named := transcript.WithSpeakerNames(m.Speakers)
+	var items []meeting.ActionItem
+	if a.TokenBudget > 0 && estimateTokens(transcriptText(named)) > a.TokenBudget {
+		parts := packChunks(transcriptLines(named), a.TokenBudget)
+		seen := make(map[string]bool)
+		for i, part := range parts {
+			prompt := fmt.Sprintf("%sThis is part %d of %d of the meeting transcript:\n\n%s%s\n", intro, i+1, len(parts), attendeeHint(m), part)
+			partItems, err := a.request(prompt)
+			if err != nil {
+				return nil, fmt.Errorf("extracting from part %d of %d: %w", i+1, len(parts), err)
+			}
+			// Items discussed across a part boundary come up twice.
+			for _, item := range partItems {
+				if key := taskKey(item.Task); !seen[key] {
+					seen[key] = true
+					items = append(items, item)
+				}
+			}
+		}
+	} else {
+		var err error
+		items, err = a.request(intro + "Here is the meeting transcript:\n\n" + promptTranscript(m, transcript))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if previous, err := meeting.ReadActions(meetingDir); err == nil {
+		carryDone(previous, items)
+	} else if !errors.Is(err, os.ErrNotExist) {
+		fmt.Fprintf(os.Stderr, "warning: could not read previous action items, done flags are reset: %v\n", err)
+	}
+
+	if err := meeting.WriteActions(meetingDir, items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// carryDone marks items done whose task matches a done item of previous,
+// ignoring case, whitespace and trailing punctuation. Each previous item
+// matches at most once.
+func carryDone(previous, items []meeting.ActionItem) {
+	done := make(map[string]int)
+	for _, item := range previous {
+		if item.Done {
+			done[taskKey(item.Task)]++
+		}
+	}
+	for i := range items {
+		key := taskKey(items[i].Task)
+		if done[key] > 0 {
+			items[i].Done = true
+			done[key]--
+		}
+	}
+}
+
+// taskKey normalizes a task for carryDone.
+func taskKey(task string) string {
+	return strings.TrimRight(strings.ToLower(strings.Join(strings.Fields(task), " ")), ".!;:")
+}
+
+// request asks the model for the action items in prompt, retrying an
+// invalid reply once with the validation error.
+func (a *Actions) request(prompt string) ([]meeting.ActionItem, error) {
+	reply, err := a.Summarizer.Summarize(actionsSystemPrompt, prompt)
+	if err != nil {
+		return nil, err
+	}
+	items, err := parseActions(reply)
+	if err != nil {
+		retry := prompt + "\n\nA previous reply was rejected: " + err.Error() + ". Reply with only the JSON object."
+		if reply, err = a.Summarizer.Summarize(actionsSystemPrompt, retry); err != nil {
+			return nil, err
+		}
+		if items, err = parseActions(reply); err != nil {
+			return nil, fmt.Errorf("invalid action items from model: %w", err)
+		}
+	}
+	return items, nil
+}
+
+// List returns the action items of every meeting that has an actions.json,
+// newest meeting first.
+func (a *Actions) List() ([]MeetingActions, error) {
+	meetings, err := a.Meetings.List()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []MeetingActions
+	for _, m := range meetings {
+		items, err := meeting.ReadActions(m.Dir)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, MeetingActions{Meeting: m, Items: items})
+	}
+	return result, nil
+}
+
+// SetDone marks item n (1-based, as listed) of the meeting in dir as done or open.
+func (a *Actions) SetDone(dir string, n int, done bool) error {
+	items, err := meeting.ReadActions(dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("no %s in %s", meeting.ActionsFile, dir)
+		}
+		return err
+	}
+	if n < 1 || n > len(items) {
+		return fmt.Errorf("no action item %d: the meeting has %d", n, len(items))
+	}
+	items[n-1].Done = done
+	return meeting.WriteActions(dir, items)
+}
+
+// extractedAction is one item of a reply; it mirrors actionsSchema.
+type extractedAction struct {
+	Task      string `json:"task"`
+	Owner     string `json:"owner"`
+	Due       string `json:"due"`
+	Timestamp string `json:"timestamp"`
+}
+
+// parseActions validates a model reply against actionsSchema. Surrounding
+// prose or code fences are tolerated.
+func parseActions(reply string) ([]meeting.ActionItem, error) {
+	start, end := strings.Index(reply, "{"), strings.LastIndex(reply, "}")
+	if start < 0 || end < start {
+		return nil, fmt.Errorf("no JSON object in reply")
+	}
+
+	dec := json.NewDecoder(strings.NewReader(reply[start : end+1]))
+	dec.DisallowUnknownFields()
+	var out struct {
+		Items *[]extractedAction `json:"items"`
+	}
+	if err := dec.Decode(&out); err != nil {
+		return nil, fmt.Errorf("reply doesn't match the schema: %w", err)
+	}
+	if out.Items == nil {
+		return nil, fmt.Errorf(`reply has no "items"`)
+	}
+
+	items := make([]meeting.ActionItem, 0, len(*out.Items))
+	for i, e := range *out.Items {
+		item := meeting.ActionItem{
+			Task:      strings.TrimSpace(e.Task),
+			Owner:     strings.TrimSpace(e.Owner),
+			Due:       strings.TrimSpace(e.Due),
+			Timestamp: strings.TrimSpace(e.Timestamp),
+		}
+		if item.Task == "" {
+			return nil, fmt.Errorf("item %d has no task", i+1)
+		}
+		if item.Due != "" {
+			if _, err := time.Parse("2006-01-02", item.Due); err != nil || !dueDatePattern.MatchString(item.Due) {
+				return nil, fmt.Errorf("item %d: due %q is not a YYYY-MM-DD date", i+1, item.Due)
+			}
+		}
+		if item.Timestamp != "" && !timestampPattern.MatchString(item.Timestamp) {
+			return nil, fmt.Errorf("item %d: timestamp %q is not hh:mm:ss", i+1, item.Timestamp)
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
@@ -0,0 +1,162 @@
+package usecases
+
+import (
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/devbydaniel/meetingcli/internal/domain/meeting"
+)
+
+func TestParseActions(t *testing.T) {
+	tests := []struct {
+		name    string
+		reply   string
+		want    []meeting.ActionItem
+		wantErr bool
+	}{
+		{
+			name:  "all fields",
+			reply: `{"items":[{"task":" Send the deck ","owner":"Ana","due":"2026-10-20","timestamp":"00:12:05"}]}`,
+			want:  []meeting.ActionItem{{Task: "Send the deck", Owner: "Ana", Due: "2026-10-20", Timestamp: "00:12:05"}},
+		},
+		{
+			name:  "code fence and prose",
+			reply: "Here you go:\n```json\n{\"items\": [{\"task\": \"Book a room\"}]}\n```",
+			want:  []meeting.ActionItem{{Task: "Book a room"}},
+		},
+		{name: "no items", reply: `{"items": []}`, want: []meeting.ActionItem{}},
+		{name: "no JSON", reply: "There are no action items.", wantErr: true},
+		{name: "missing items", reply: `{}`, wantErr: true},
+		{name: "unknown field", reply: `{"items":[{"task":"x","priority":"high"}]}`, wantErr: true},
+		{name: "empty task", reply: `{"items":[{"task":"  "}]}`, wantErr: true},
+		{name: "due not a date", reply: `{"items":[{"task":"x","due":"next Friday"}]}`, wantErr: true},
+		{name: "due out of range", reply: `{"items":[{"task":"x","due":"2026-13-01"}]}`, wantErr: true},
+		{name: "bad timestamp", reply: `{"items":[{"task":"x","timestamp":"12:05"}]}`, wantErr: true},
+		{name: "malformed", reply: `{"items":[{"task":"x"}`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseActions(tt.reply)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %+v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCarryDone(t *testing.T) {
+	tests := []struct {
+		name     string
+		previous []meeting.ActionItem
+		items    []string
+		want     []bool
+	}{
+		{
+			name:     "matching tasks stay done",
+			previous: []meeting.ActionItem{{Task: "Send the deck.", Done: true}, {Task: "Book a room"}},
+			items:    []string{"send  the deck", "Book a room", "Invite Ben"},
+			want:     []bool{true, false, false},
+		},
+		{
+			name:     "each done item matches once",
+			previous: []meeting.ActionItem{{Task: "Follow up", Done: true}},
+			items:    []string{"Follow up", "Follow up"},
+			want:     []bool{true, false},
+		},
+		{
+			name:  "no previous items",
+			items: []string{"Follow up"},
+			want:  []bool{false},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items := make([]meeting.ActionItem, len(tt.items))
+			for i, task := range tt.items {
+				items[i] = meeting.ActionItem{Task: task}
+			}
+			carryDone(tt.previous, items)
+			for i, item := range items {
+				if item.Done != tt.want[i] {
+					t.Errorf("item %q done = %v, want %v", item.Task, item.Done, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+// funcSummarizer replies with the result of its function.
+type funcSummarizer func(systemPrompt, prompt string) (string, error)
+
+func (f funcSummarizer) Summarize(systemPrompt, prompt string) (string, error) {
+	return f(systemPrompt, prompt)
+}
+
+func (f funcSummarizer) Model() string { return "fake" }
+
+func TestActionsExtractInParts(t *testing.T) {
+	var segments []meeting.TranscriptSegment
+	for i := range 6 {
+		segments = append(segments, seg(fmt.Sprintf("speaker_%d", i%2), strings.Repeat("talk ", 20), float64(i*10), float64(i*10+9)))
+	}
+	transcript := &meeting.Transcript{Segments: segments}
+
+	tests := []struct {
+		name      string
+		budget    int
+		wantParts int
+		want      []string
+	}{
+		{name: "under budget", budget: 0, wantParts: 1, want: []string{"Shared task", "Task 1"}},
+		{name: "over budget", budget: 70, wantParts: 3, want: []string{"Shared task", "Task 1", "Task 2", "Task 3"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var prompts []string
+			summarizer := funcSummarizer(func(_, prompt string) (string, error) {
+				prompts = append(prompts, prompt)
+				return fmt.Sprintf(`{"items":[{"task":"Shared task"},{"task":"Task %d"}]}`, len(prompts)), nil
+			})
+			dir := t.TempDir()
+			a := &Actions{Summarizer: summarizer, Meetings: meeting.NewRepository(filepath.Dir(dir)), TokenBudget: tt.budget}
+
+			items, err := a.Extract(transcript, dir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(prompts) != tt.wantParts {
+				t.Fatalf("sent %d requests, want %d", len(prompts), tt.wantParts)
+			}
+			var tasks []string
+			for _, item := range items {
+				tasks = append(tasks, item.Task)
+			}
+			if !slices.Equal(tasks, tt.want) {
+				t.Errorf("got %v, want %v", tasks, tt.want)
+			}
+			if tt.budget > 0 {
+				for i, prompt := range prompts {
+					if !strings.Contains(prompt, fmt.Sprintf("part %d of %d", i+1, tt.wantParts)) {
+						t.Errorf("prompt %d doesn't say which part it is:\n%s", i+1, prompt)
+					}
+				}
+			}
+		})
+	}
+}
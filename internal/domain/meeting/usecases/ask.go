@@ -0,0 +1,197 @@
+package usecases
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/devbydaniel/meetingcli/internal/domain/meeting"
+)
+
+const askSystemPrompt = `You answer questions about a meeting using its transcript and summary. Transcript lines start with an [hh:mm:ss] timestamp; cite the timestamps your answer is based on, e.g. [00:12:34]. Be concise. If the transcript doesn't answer the question, say so instead of guessing.`
+
+const askAllSystemPrompt = `You answer questions about past meetings using passages retrieved from their transcripts and summaries. Each passage starts with its meeting folder and either an hh:mm:ss timestamp or "summary" in brackets; cite the passages your answer is based on exactly that way, e.g. [2026-02-06_14-00-00 00:12:34]. Be concise. If the passages don't answer the question, say so instead of guessing.`
+
+// askPassages is the number of passages retrieved per question when asking
+// across meetings.
+const askPassages = 20
+
+// Chatter streams a language model's reply to a conversation.
+type Chatter interface {
+	// Chat sends messages with systemPrompt and writes the reply to w as it
+	// is generated. Returns the complete reply.
+	Chat(systemPrompt string, messages []meeting.ChatMessage, w io.Writer) (string, error)
+}
+
+// Ask answers questions about a meeting, or across meetings with passages
+// retrieved by Search, via the configured Chatter.
+type Ask struct {
+	Chatter  Chatter
+	Meetings *meeting.Repository
+	Search   *Search
+	// A meeting whose transcript and summary are estimated at more than
+	// TokenBudget tokens is asked about with retrieved passages, as across
+	// meetings. Zero always sends the whole meeting.
+	TokenBudget int
+}
+
+// Answer is the reply to a question.
+type Answer struct {
+	Text string
+	// Sources are the retrieved passages cited in Text. Empty when the
+	// whole meeting was sent, or nothing was cited.
+	Sources []SearchHit
+	// Passages is the number of passages retrieved for the question.
+	Passages int
+}
+
+// Conversation is a chat about one or more meetings. Each question is sent
+// with the meeting context and the earlier questions and answers.
+type Conversation struct {
+	chatter      Chatter
+	systemPrompt string
+	messages     []meeting.ChatMessage
+	// retrieve finds the passages sent with a question, when asking across
+	// meetings.
+	retrieve func(query string) ([]SearchHit, error)
+	previous string // last question, to retrieve for follow-ups
+}
+
+// Start begins a conversation about the meeting in meetingDir, whose
+// transcript and summary are sent with every question. Over TokenBudget,
+// each question is sent with the summary if it fits and the passages of the
+// meeting the search index ranks highest for it.
+func (a *Ask) Start(meetingDir string) (*Conversation, error) {
+	transcript, err := meeting.ReadTranscript(meetingDir)
+	if err != nil {
+		return nil, err
+	}
+	m := loadMeeting(a.Meetings, meetingDir)
+
+	var summary string
+	if data, err := os.ReadFile(filepath.Join(meetingDir, meeting.SummaryFile)); err == nil {
+		summary = "Meeting summary:\n\n" + strings.TrimSpace(string(data)) + "\n\n"
+	}
+	text := promptTranscript(m, transcript)
+
+	if a.TokenBudget > 0 && estimateTokens(summary+text) > a.TokenBudget {
+		if estimateTokens(summary) > a.TokenBudget/2 {
+			summary = ""
+		}
+		opts := SearchOptions{Limit: askPassages, Meeting: filepath.Base(meetingDir)}
+		return &Conversation{
+			chatter:      a.Chatter,
+			systemPrompt: askAllSystemPrompt + "\n\n" + summary,
+			retrieve: func(query string) ([]SearchHit, error) {
+				return a.Search.Query(query, opts)
+			},
+		}, nil
+	}
+
+	return &Conversation{
+		chatter:      a.Chatter,
+		systemPrompt: askSystemPrompt + "\n\n" + summary + "Meeting transcript:\n\n" + text,
+	}, nil
+}
+
+// StartAll begins a conversation across the meetings started since since
+// (all meetings if zero). Each question is sent with the passages the
+// search index ranks highest for it.
+func (a *Ask) StartAll(since time.Time) *Conversation {
+	return &Conversation{
+		chatter:      a.Chatter,
+		systemPrompt: askAllSystemPrompt,
+		retrieve: func(query string) ([]SearchHit, error) {
+			return a.Search.Query(query, SearchOptions{Limit: askPassages, Since: since})
+		},
+	}
+}
+
+// Ask sends question and streams the answer to w. A failed question is
+// dropped from the history so it can be asked again.
+func (c *Conversation) Ask(question string, w io.Writer) (*Answer, error) {
+	prompt := question
+	var passages []SearchHit
+	if c.retrieve != nil {
+		// Follow-ups such as "who owns that?" rarely match on their own.
+		var err error
+		passages, err = c.retrieve(strings.TrimSpace(c.previous + " " + question))
+		if err != nil {
+			return nil, err
+		}
+		prompt = passagesPrompt(passages) + "Question: " + question
+	}
+
+	messages := append(slices.Clone(c.messages), meeting.ChatMessage{Role: meeting.RoleUser, Content: prompt})
+	text, err := c.chatter.Chat(c.systemPrompt, messages, w)
+	if err != nil {
+		return nil, err
+	}
+
+	// Passages are left out of the history; each question brings its own.
+	c.messages = append(c.messages,
+		meeting.ChatMessage{Role: meeting.RoleUser, Content: question},
+		meeting.ChatMessage{Role: meeting.RoleAssistant, Content: text},
+	)
+	c.previous = question
+	return &Answer{Text: text, Sources: citedPassages(text, passages), Passages: len(passages)}, nil
+}
+
+// passageLabel is how a passage is introduced to the model and cited.
+func passageLabel(h SearchHit) string {
+	if h.Source == meeting.ChunkSummary {
+		return h.Meeting + " " + meeting.ChunkSummary
+	}
+	return h.Meeting + " " + h.Timestamp
+}
+
+// passagesPrompt lists the retrieved passages for the model.
+func passagesPrompt(passages []SearchHit) string {
+	if len(passages) == 0 {
+		return "No passages in the meetings matched the question.\n\n"
+	}
+	var sb strings.Builder
+	sb.WriteString("Passages from the meetings:\n\n")
+	for _, h := range passages {
+		sb.WriteString("[" + passageLabel(h) + "] ")
+		if h.Speaker != "" {
+			sb.WriteString(h.Speaker + ": ")
+		}
+		sb.WriteString(h.Text + "\n")
+	}
+	return sb.String() + "\n"
+}
+
+// citationPattern matches a bracketed or parenthesized citation.
+var citationPattern = regexp.MustCompile(`[\[(][^\[\]()]*[\])]`)
+
+// citedPassages returns the passages cited in answer. Models don't always
+// keep the exact label, so a citation matches a passage if it names the
+// passage's meeting folder and timestamp (or "summary") in any form. When
+// all passages are from one meeting, the timestamp alone is enough.
+func citedPassages(answer string, passages []SearchHit) []SearchHit {
+	oneMeeting := true
+	for _, h := range passages {
+		oneMeeting = oneMeeting && h.Meeting == passages[0].Meeting
+	}
+	citations := citationPattern.FindAllString(answer, -1)
+
+	var cited []SearchHit
+	for _, h := range passages {
+		location := h.Timestamp
+		if h.Source == meeting.ChunkSummary {
+			location = meeting.ChunkSummary
+		}
+		for _, c := range citations {
+			if strings.Contains(c, location) && (oneMeeting || strings.Contains(c, h.Meeting)) {
+				cited = append(cited, h)
+				break
+			}
+		}
+	}
+	return cited
+}
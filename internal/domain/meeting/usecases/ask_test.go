@@ -0,0 +1,148 @@
+package usecases
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/devbydaniel/meetingcli/internal/domain/meeting"
+)
+
+func TestAskStartTokenBudget(t *testing.T) {
+	meetingsDir := t.TempDir()
+	for _, name := range []string{"planning", "retro"} {
+		dir := filepath.Join(meetingsDir, name)
+		if err := os.Mkdir(dir, 0o755); err != nil {
+			t.Fatal(err)
+		}
+		transcript := &meeting.Transcript{Segments: []meeting.TranscriptSegment{
+			seg("speaker_0", "The budget for "+name+" is fixed.", 0, 5),
+			seg("speaker_1", strings.Repeat("Other topics. ", 50), 5, 60),
+		}}
+		if err := meeting.WriteTranscript(dir, transcript); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Meetings transcribed before transcript.json existed only have
+	// transcript.md.
+	archive := filepath.Join(meetingsDir, "archive")
+	if err := os.Mkdir(archive, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	md := "# Meeting Transcript\n\nThe budget for archive is fixed.\n\n" + strings.Repeat("Other topics. ", 50) + "\n"
+	if err := os.WriteFile(filepath.Join(archive, meeting.TranscriptFile), []byte(md), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	repo := meeting.NewRepository(meetingsDir)
+
+	tests := []struct {
+		name     string
+		meeting  string
+		budget   int
+		retrieve bool
+	}{
+		{name: "no budget", meeting: "planning", budget: 0},
+		{name: "under budget", meeting: "planning", budget: 1000},
+		{name: "over budget", meeting: "planning", budget: 100, retrieve: true},
+		{name: "over budget, markdown only", meeting: "archive", budget: 100, retrieve: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &Ask{Meetings: repo, Search: &Search{Meetings: repo}, TokenBudget: tt.budget}
+			conv, err := a.Start(filepath.Join(meetingsDir, tt.meeting))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !tt.retrieve {
+				if conv.retrieve != nil || !strings.Contains(conv.systemPrompt, "Other topics.") {
+					t.Fatal("expected the whole transcript in the system prompt")
+				}
+				return
+			}
+			if conv.retrieve == nil || strings.Contains(conv.systemPrompt, "Other topics.") {
+				t.Fatal("expected passages to be retrieved instead of the transcript")
+			}
+			hits, err := conv.retrieve("budget")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(hits) != 1 || hits[0].Meeting != tt.meeting {
+				t.Errorf("retrieved %+v, want the %s passage only", hits, tt.meeting)
+			}
+		})
+	}
+}
+
+func TestCitedPassages(t *testing.T) {
+	planning := SearchHit{Meeting: "2026-02-06_14-00-00_planning", Timestamp: "00:12:34"}
+	summary := SearchHit{Meeting: "2026-02-06_14-00-00_planning", Source: meeting.ChunkSummary}
+	standup := SearchHit{Meeting: "2026-02-09_09-30-00_standup", Timestamp: "00:01:05"}
+	all := []SearchHit{planning, summary, standup}
+
+	tests := []struct {
+		name     string
+		answer   string
+		passages []SearchHit
+		want     []SearchHit
+	}{
+		{
+			name:     "exact labels",
+			answer:   "We chose Postgres [2026-02-06_14-00-00_planning 00:12:34] and Ben owns it [2026-02-09_09-30-00_standup 00:01:05].",
+			passages: all,
+			want:     []SearchHit{planning, standup},
+		},
+		{
+			name:     "reworded citation",
+			answer:   "We chose Postgres (2026-02-06_14-00-00_planning, at 00:12:34).",
+			passages: all,
+			want:     []SearchHit{planning},
+		},
+		{
+			name:     "several passages in one citation",
+			answer:   "Decided twice [2026-02-06_14-00-00_planning summary; 2026-02-09_09-30-00_standup 00:01:05].",
+			passages: all,
+			want:     []SearchHit{summary, standup},
+		},
+		{
+			name:     "timestamp of another meeting",
+			answer:   "Postgres [2026-02-09_09-30-00_standup 00:12:34].",
+			passages: all,
+			want:     nil,
+		},
+		{
+			name:     "bare timestamp with one meeting",
+			answer:   "We chose Postgres [00:12:34].",
+			passages: []SearchHit{planning, summary},
+			want:     []SearchHit{planning},
+		},
+		{
+			name:     "bare timestamp with several meetings",
+			answer:   "We chose Postgres [00:12:34].",
+			passages: all,
+			want:     nil,
+		},
+		{
+			name:     "nothing cited",
+			answer:   "The passages don't say.",
+			passages: all,
+			want:     nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := citedPassages(tt.answer, tt.passages)
+			if len(got) != len(tt.want) {
+				t.Fatalf("citedPassages = %+v, want %+v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("passage %d = %+v, want %+v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
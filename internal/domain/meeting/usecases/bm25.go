@@ -0,0 +1,84 @@
+package usecases
+
+import (
+	"math"
+	"strings"
+	"unicode"
+)
+
+// BM25 parameters: term frequency saturation and document length
+// normalization, at their usual values.
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+// tokenize splits s into lowercase words for full-text search.
+func tokenize(s string) []string {
+	return strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+}
+
+// bm25Scores scores each tokenized document against the query terms with
+// Okapi BM25. Documents without any query term score zero.
+func bm25Scores(docs [][]string, query []string) []float64 {
+	scores := make([]float64, len(docs))
+	if len(docs) == 0 || len(query) == 0 {
+		return scores
+	}
+
+	totalLen := 0
+	for _, doc := range docs {
+		totalLen += len(doc)
+	}
+	avgLen := max(float64(totalLen)/float64(len(docs)), 1)
+
+	terms := make(map[string]bool, len(query))
+	for _, term := range query {
+		terms[term] = true
+	}
+
+	// Term frequencies per document, and document frequencies per term.
+	freqs := make([]map[string]int, len(docs))
+	df := make(map[string]int, len(terms))
+	for i, doc := range docs {
+		freqs[i] = make(map[string]int)
+		for _, word := range doc {
+			if terms[word] {
+				freqs[i][word]++
+			}
+		}
+		for term := range freqs[i] {
+			df[term]++
+		}
+	}
+
+	n := float64(len(docs))
+	for i, doc := range docs {
+		norm := bm25K1 * (1 - bm25B + bm25B*float64(len(doc))/avgLen)
+		for term, tf := range freqs[i] {
+			idf := math.Log(1 + (n-float64(df[term])+0.5)/(float64(df[term])+0.5))
+			scores[i] += idf * float64(tf) * (bm25K1 + 1) / (float64(tf) + norm)
+		}
+	}
+	return scores
+}
+
+// cosineSimilarity returns the cosine of the angle between a and b, or zero
+// if their lengths differ.
+func cosineSimilarity(a, b []float32) float64 {
+	if len(a) != len(b) || len(a) == 0 {
+		return 0
+	}
+	var dot, normA, normB float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		normA += float64(a[i]) * float64(a[i])
+		normB += float64(b[i]) * float64(b[i])
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
@@ -0,0 +1,91 @@
+package usecases
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBM25Scores(t *testing.T) {
+	tests := []struct {
+		name  string
+		docs  [][]string
+		query []string
+		check func(t *testing.T, scores []float64)
+	}{
+		{
+			name:  "no documents",
+			query: []string{"a"},
+			check: func(t *testing.T, scores []float64) {
+				if len(scores) != 0 {
+					t.Errorf("got %v", scores)
+				}
+			},
+		},
+		{
+			name: "no query",
+			docs: [][]string{{"a"}, {"b"}},
+			check: func(t *testing.T, scores []float64) {
+				if scores[0] != 0 || scores[1] != 0 {
+					t.Errorf("got %v, want zeros", scores)
+				}
+			},
+		},
+		{
+			name:  "single match",
+			docs:  [][]string{{"a"}, {"b"}},
+			query: []string{"a"},
+			check: func(t *testing.T, scores []float64) {
+				// idf = ln(1 + 1.5/1.5), and the length-normalized tf is 1.
+				if math.Abs(scores[0]-math.Ln2) > 1e-12 || scores[1] != 0 {
+					t.Errorf("got %v, want [ln 2, 0]", scores)
+				}
+			},
+		},
+		{
+			name:  "repeated query terms count once",
+			docs:  [][]string{{"a"}, {"b"}},
+			query: []string{"a", "a"},
+			check: func(t *testing.T, scores []float64) {
+				if math.Abs(scores[0]-math.Ln2) > 1e-12 {
+					t.Errorf("got %v, want [ln 2, 0]", scores)
+				}
+			},
+		},
+		{
+			name:  "rare terms weigh more",
+			docs:  [][]string{{"apple", "pie"}, {"apple", "cherry"}, {"apple", "tart"}},
+			query: []string{"apple", "cherry"},
+			check: func(t *testing.T, scores []float64) {
+				if !(scores[1] > scores[0] && scores[0] == scores[2] && scores[0] > 0) {
+					t.Errorf("got %v, want the cherry document first and the others equal", scores)
+				}
+			},
+		},
+		{
+			name:  "term frequency",
+			docs:  [][]string{{"x", "x", "y"}, {"x", "y", "y"}},
+			query: []string{"x"},
+			check: func(t *testing.T, scores []float64) {
+				if !(scores[0] > scores[1]) {
+					t.Errorf("got %v, want the document repeating x first", scores)
+				}
+			},
+		},
+		{
+			name:  "length normalization",
+			docs:  [][]string{{"x", "a", "b", "c", "d"}, {"x"}},
+			query: []string{"x"},
+			check: func(t *testing.T, scores []float64) {
+				if !(scores[1] > scores[0]) {
+					t.Errorf("got %v, want the shorter document first", scores)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.check(t, bm25Scores(tt.docs, tt.query))
+		})
+	}
+}
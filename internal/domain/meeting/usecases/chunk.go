@@ -0,0 +1,180 @@
+package usecases
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/devbydaniel/meetingcli/internal/domain/meeting"
+)
+
+// transcribeChunked transcribes audioPath, splitting long recordings into
+// chunks that are transcribed concurrently and stitched back together. Every
+// request to the Transcriber holds a slot of sem, which may be shared with
+// other recordings transcribed at the same time.
+func (t *Transcribe) transcribeChunked(audioPath string, sem chan struct{}) (*meeting.Transcript, error) {
+	if t.Splitter == nil || t.ChunkDuration <= 0 {
+		return transcribeWithSlot(t.Transcriber, audioPath, sem)
+	}
+
+	tmpDir, err := os.MkdirTemp("", "meetingcli-chunks-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	chunks, err := t.Splitter.Split(audioPath, tmpDir, t.ChunkDuration, t.ChunkOverlap)
+	if err != nil {
+		return nil, fmt.Errorf("splitting audio: %w", err)
+	}
+	if len(chunks) == 1 {
+		return transcribeWithSlot(t.Transcriber, chunks[0].Path, sem)
+	}
+
+	responses := make([]*meeting.Transcript, len(chunks))
+	errs := make([]error, len(chunks))
+	var wg sync.WaitGroup
+	for i, chunk := range chunks {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			responses[i], errs[i] = t.Transcriber.Transcribe(chunk.Path)
+		}()
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			return nil, fmt.Errorf("transcribing chunk %d of %d: %w", i+1, len(chunks), err)
+		}
+	}
+
+	return stitchTranscripts(chunks, responses), nil
+}
+
+// transcribeWithSlot transcribes audioPath once a slot of sem is free.
+func transcribeWithSlot(transcriber Transcriber, audioPath string, sem chan struct{}) (*meeting.Transcript, error) {
+	sem <- struct{}{}
+	defer func() { <-sem }()
+	return transcriber.Transcribe(audioPath)
+}
+
+// stitchTranscripts merges per-chunk transcripts into one with absolute
+// timestamps. Where chunks overlap, each segment is kept from the chunk that
+// owns its midpoint. Diarization labels are per request, so each chunk's
+// speakers are mapped onto the previous chunk's by how much they talk at the
+// same time in the overlap.
+func stitchTranscripts(chunks []meeting.AudioChunk, responses []*meeting.Transcript) *meeting.Transcript {
+	merged := &meeting.Transcript{}
+	used := make(map[string]bool)
+	var texts []string
+	var prev []meeting.TranscriptSegment
+
+	for i, chunk := range chunks {
+		offset := chunk.Start.Seconds()
+		ownFrom := (chunk.Start + chunk.Overlap).Seconds()
+		ownTo := math.Inf(1)
+		if i+1 < len(chunks) {
+			ownTo = (chunks[i+1].Start + chunks[i+1].Overlap).Seconds()
+		}
+
+		segments := make([]meeting.TranscriptSegment, len(responses[i].Segments))
+		for j, seg := range responses[i].Segments {
+			seg.Start += offset
+			seg.End += offset
+			segments[j] = seg
+		}
+
+		if i > 0 {
+			labels := matchSpeakers(prev, segments, offset, ownFrom, used)
+			for j := range segments {
+				segments[j].Speaker = labels[segments[j].Speaker]
+			}
+		}
+
+		if len(segments) == 0 {
+			// Without segments there is nothing to dedupe against.
+			texts = append(texts, strings.TrimSpace(responses[i].Text))
+		}
+		for _, seg := range segments {
+			mid := (seg.Start + seg.End) / 2
+			if mid >= ownFrom && mid < ownTo {
+				merged.Segments = append(merged.Segments, seg)
+				texts = append(texts, strings.TrimSpace(seg.Text))
+			}
+			used[seg.Speaker] = true
+		}
+		prev = segments
+	}
+
+	merged.Text = strings.Join(texts, " ")
+	return merged
+}
+
+// matchSpeakers maps the speaker labels of cur onto the labels of prev, using
+// how long each pair talks simultaneously within [from, to). Labels with no
+// counterpart get a fresh label not yet in used.
+func matchSpeakers(prev, cur []meeting.TranscriptSegment, from, to float64, used map[string]bool) map[string]string {
+	type pair struct {
+		local, global string
+		overlap       float64
+	}
+	totals := make(map[[2]string]float64)
+	for _, c := range cur {
+		for _, p := range prev {
+			start := max(c.Start, p.Start, from)
+			end := min(c.End, p.End, to)
+			if end > start {
+				totals[[2]string{c.Speaker, p.Speaker}] += end - start
+			}
+		}
+	}
+	pairs := make([]pair, 0, len(totals))
+	for k, v := range totals {
+		pairs = append(pairs, pair{local: k[0], global: k[1], overlap: v})
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i].overlap != pairs[j].overlap {
+			return pairs[i].overlap > pairs[j].overlap
+		}
+		return pairs[i].local < pairs[j].local
+	})
+
+	labels := make(map[string]string)
+	taken := make(map[string]bool)
+	for _, p := range pairs {
+		if _, ok := labels[p.local]; ok || taken[p.global] {
+			continue
+		}
+		labels[p.local] = p.global
+		taken[p.global] = true
+	}
+
+	for _, c := range cur {
+		if _, ok := labels[c.Speaker]; ok {
+			continue
+		}
+		if c.Speaker == "" {
+			labels[""] = ""
+			continue
+		}
+		labels[c.Speaker] = freshSpeakerLabel(used, taken)
+		taken[labels[c.Speaker]] = true
+	}
+	return labels
+}
+
+// freshSpeakerLabel returns the first speaker_N label not in either set.
+func freshSpeakerLabel(used, taken map[string]bool) string {
+	for n := 0; ; n++ {
+		label := fmt.Sprintf("speaker_%d", n)
+		if !used[label] && !taken[label] {
+			return label
+		}
+	}
+}
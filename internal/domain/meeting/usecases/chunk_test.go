@@ -0,0 +1,132 @@
+package usecases
+
+import (
+	"maps"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/devbydaniel/meetingcli/internal/domain/meeting"
+)
+
+func seg(speaker, text string, start, end float64) meeting.TranscriptSegment {
+	return meeting.TranscriptSegment{Speaker: speaker, Text: text, Start: start, End: end}
+}
+
+func TestStitchTranscripts(t *testing.T) {
+	tests := []struct {
+		name      string
+		chunks    []meeting.AudioChunk
+		responses []*meeting.Transcript
+		want      *meeting.Transcript
+	}{
+		{
+			name: "overlap is deduped and speakers are matched",
+			chunks: []meeting.AudioChunk{
+				{End: 10 * time.Second},
+				{Start: 8 * time.Second, End: 20 * time.Second, Overlap: 2 * time.Second},
+			},
+			responses: []*meeting.Transcript{
+				{Segments: []meeting.TranscriptSegment{
+					seg("speaker_0", "hello", 0, 4),
+					seg("speaker_1", "hi there", 4, 9),
+					seg("speaker_0", "so", 9, 10),
+				}},
+				// Relative to 8s; this request labelled the speakers the other way round.
+				{Segments: []meeting.TranscriptSegment{
+					seg("speaker_0", "there", 0, 1),
+					seg("speaker_1", "so", 1, 2),
+					seg("speaker_1", "let's start", 3, 6),
+					seg("speaker_5", "agreed", 6, 8),
+				}},
+			},
+			want: &meeting.Transcript{
+				Text: "hello hi there so let's start agreed",
+				Segments: []meeting.TranscriptSegment{
+					seg("speaker_0", "hello", 0, 4),
+					seg("speaker_1", "hi there", 4, 9),
+					seg("speaker_0", "so", 9, 10),
+					seg("speaker_0", "let's start", 11, 14),
+					seg("speaker_2", "agreed", 14, 16),
+				},
+			},
+		},
+		{
+			name: "chunks without segments keep their text",
+			chunks: []meeting.AudioChunk{
+				{End: 10 * time.Second},
+				{Start: 8 * time.Second, End: 20 * time.Second, Overlap: 2 * time.Second},
+			},
+			responses: []*meeting.Transcript{
+				{Text: " first part "},
+				{Text: "second part"},
+			},
+			want: &meeting.Transcript{Text: "first part second part"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := stitchTranscripts(tt.chunks, tt.responses)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v\nwant %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchSpeakers(t *testing.T) {
+	tests := []struct {
+		name     string
+		prev     []meeting.TranscriptSegment
+		cur      []meeting.TranscriptSegment
+		from, to float64
+		used     []string
+		want     map[string]string
+	}{
+		{
+			name: "labels follow simultaneous speech",
+			prev: []meeting.TranscriptSegment{seg("speaker_0", "", 0, 5), seg("speaker_1", "", 5, 10)},
+			cur:  []meeting.TranscriptSegment{seg("speaker_1", "", 0, 5), seg("speaker_0", "", 5, 10)},
+			from: 0, to: 10,
+			used: []string{"speaker_0", "speaker_1"},
+			want: map[string]string{"speaker_1": "speaker_0", "speaker_0": "speaker_1"},
+		},
+		{
+			name: "largest overlap wins a contested label",
+			prev: []meeting.TranscriptSegment{seg("speaker_0", "", 0, 10)},
+			cur:  []meeting.TranscriptSegment{seg("a", "", 0, 3), seg("b", "", 3, 10)},
+			from: 0, to: 10,
+			used: []string{"speaker_0"},
+			want: map[string]string{"b": "speaker_0", "a": "speaker_1"},
+		},
+		{
+			name: "speech outside the window is ignored",
+			prev: []meeting.TranscriptSegment{seg("speaker_0", "", 0, 4), seg("speaker_1", "", 4, 10)},
+			cur:  []meeting.TranscriptSegment{seg("x", "", 0, 10)},
+			from: 6, to: 10,
+			used: []string{"speaker_0", "speaker_1"},
+			want: map[string]string{"x": "speaker_1"},
+		},
+		{
+			name: "new speakers skip labels in use",
+			cur:  []meeting.TranscriptSegment{seg("x", "", 0, 1), seg("", "", 1, 2)},
+			from: 0, to: 2,
+			used: []string{"speaker_0", "speaker_2"},
+			want: map[string]string{"x": "speaker_1", "": ""},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			used := make(map[string]bool)
+			for _, label := range tt.used {
+				used[label] = true
+			}
+			got := matchSpeakers(tt.prev, tt.cur, tt.from, tt.to, used)
+			if !maps.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/devbydaniel/meetingcli/internal/domain/meeting"
+)
+
+// Subtitle formats supported by Export.
+const (
+	SubtitleSRT = "srt"
+	SubtitleVTT = "vtt"
+)
+
+// Export writes subtitle files from a meeting's transcript.json. Segments are
+// split so no caption is longer than MaxChars characters or MaxDuration;
+// zero disables the respective limit.
+type Export struct {
+	MaxChars    int
+	MaxDuration time.Duration
+	Meetings    *meeting.Repository // for assigned speaker names
+}
+
+// Execute writes recording.srt or recording.vtt to meetingDir and returns its path.
+func (e *Export) Execute(meetingDir, format string) (string, error) {
+	transcript, err := meeting.ReadTranscript(meetingDir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", fmt.Errorf("no %s in %s: run `meeting transcribe` first", meeting.TranscriptDataFile, meetingDir)
+		}
+		return "", err
+	}
+	m := loadMeeting(e.Meetings, meetingDir)
+	return e.write(transcript.WithSpeakerNames(m.Speakers), meetingDir, format)
+}
+
+func (e *Export) write(transcript *meeting.Transcript, meetingDir, format string) (string, error) {
+	if len(transcript.Segments) == 0 {
+		return "", fmt.Errorf("transcript has no timestamps: re-run `meeting transcribe --force`")
+	}
+
+	var content, file string
+	captions := e.captions(transcript.Segments)
+	switch format {
+	case SubtitleSRT:
+		content, file = formatSRT(captions), meeting.SRTFile
+	case SubtitleVTT:
+		content, file = formatVTT(captions), meeting.VTTFile
+	default:
+		return "", fmt.Errorf("unknown subtitle format %q (expected srt or vtt)", format)
+	}
+
+	path := filepath.Join(meetingDir, file)
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		return "", fmt.Errorf("writing %s: %w", file, err)
+	}
+	return path, nil
+}
+
+// caption is a single subtitle cue. Start and End are seconds.
+type caption struct {
+	Speaker    string
+	Text       string
+	Start, End float64
+}
+
+// captions splits segments into cues within the length and duration limits.
+func (e *Export) captions(segments []meeting.TranscriptSegment) []caption {
+	var captions []caption
+	for _, seg := range segments {
+		captions = append(captions, e.splitSegment(seg)...)
+	}
+	return captions
+}
+
+// splitSegment breaks seg at word boundaries into roughly equal pieces, as
+// few as the limits allow, and shares its time between them by length.
+func (e *Export) splitSegment(seg meeting.TranscriptSegment) []caption {
+	words := strings.Fields(seg.Text)
+	if len(words) == 0 {
+		return nil
+	}
+	chars := utf8.RuneCountInString(strings.Join(words, " "))
+	duration := max(seg.End-seg.Start, 0)
+
+	n := 1
+	if e.MaxChars > 0 {
+		n = max(n, (chars+e.MaxChars-1)/e.MaxChars)
+	}
+	if e.MaxDuration > 0 {
+		n = max(n, int(math.Ceil(duration/e.MaxDuration.Seconds())))
+	}
+	n = min(n, len(words))
+	target := float64(chars) / float64(n)
+
+	var pieces []string
+	var current []string
+	currentLen := 0
+	for _, word := range words {
+		wordLen := utf8.RuneCountInString(word)
+		if currentLen > 0 && (float64(currentLen) >= target || (e.MaxChars > 0 && currentLen+1+wordLen > e.MaxChars)) {
+			pieces = append(pieces, strings.Join(current, " "))
+			current, currentLen = nil, 0
+		}
+		if currentLen > 0 {
+			currentLen++
+		}
+		current = append(current, word)
+		currentLen += wordLen
+	}
+	pieces = append(pieces, strings.Join(current, " "))
+
+	total := 0
+	for _, p := range pieces {
+		total += utf8.RuneCountInString(p)
+	}
+	captions := make([]caption, len(pieces))
+	start := seg.Start
+	for i, p := range pieces {
+		end := start + duration*float64(utf8.RuneCountInString(p))/float64(total)
+		if i == len(pieces)-1 {
+			end = seg.Start + duration
+		}
+		captions[i] = caption{Speaker: seg.Speaker, Text: p, Start: start, End: end}
+		start = end
+	}
+	return captions
+}
+
+func formatSRT(captions []caption) string {
+	var sb strings.Builder
+	for i, c := range captions {
+		text := c.Text
+		if c.Speaker != "" {
+			text = c.Speaker + ": " + text
+		}
+		fmt.Fprintf(&sb, "%d\n%s --> %s\n%s\n\n", i+1, subtitleTime(c.Start, ','), subtitleTime(c.End, ','), text)
+	}
+	return sb.String()
+}
+
+// vttEscaper escapes the characters WebVTT cue text reserves for markup.
+var vttEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+func formatVTT(captions []caption) string {
+	var sb strings.Builder
+	sb.WriteString("WEBVTT\n\n")
+	for _, c := range captions {
+		text := vttEscaper.Replace(c.Text)
+		if c.Speaker != "" {
+			// Voice spans let players style or label speakers.
+			text = "<v " + vttEscaper.Replace(c.Speaker) + ">" + text
+		}
+		fmt.Fprintf(&sb, "%s --> %s\n%s\n\n", subtitleTime(c.Start, '.'), subtitleTime(c.End, '.'), text)
+	}
+	return sb.String()
+}
+
+// subtitleTime formats seconds as hh:mm:ss followed by sep and milliseconds.
+func subtitleTime(seconds float64, sep rune) string {
+	ms := int64(math.Round(max(seconds, 0) * 1000))
+	return fmt.Sprintf("%02d:%02d:%02d%c%03d", ms/3_600_000, ms/60_000%60, ms/1000%60, sep, ms%1000)
+}
@@ -0,0 +1,126 @@
+package usecases
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/devbydaniel/meetingcli/internal/domain/meeting"
+)
+
+func TestSplitSegment(t *testing.T) {
+	tests := []struct {
+		name        string
+		maxChars    int
+		maxDuration time.Duration
+		seg         meeting.TranscriptSegment
+		want        []caption
+	}{
+		{
+			name:     "fits in one caption",
+			maxChars: 84,
+			seg:      seg("speaker_0", "  hello   world ", 1, 3),
+			want:     []caption{{Speaker: "speaker_0", Text: "hello world", Start: 1, End: 3}},
+		},
+		{
+			name:     "split by length",
+			maxChars: 10,
+			seg:      seg("", "aaaa bbbb cccc dddd", 0, 19),
+			want: []caption{
+				{Text: "aaaa bbbb", Start: 0, End: 9.5},
+				{Text: "cccc dddd", Start: 9.5, End: 19},
+			},
+		},
+		{
+			name:        "split by duration, time shared by length",
+			maxDuration: 5 * time.Second,
+			seg:         seg("speaker_1", "one two three four five six", 0, 12),
+			want: []caption{
+				{Speaker: "speaker_1", Text: "one two three", Start: 0, End: 6.24},
+				{Speaker: "speaker_1", Text: "four five", Start: 6.24, End: 10.56},
+				{Speaker: "speaker_1", Text: "six", Start: 10.56, End: 12},
+			},
+		},
+		{
+			name:     "a long word is never broken",
+			maxChars: 5,
+			seg:      seg("", "incomprehensibilities ok", 0, 5),
+			want: []caption{
+				{Text: "incomprehensibilities", Start: 0, End: 5 * 21.0 / 23},
+				{Text: "ok", Start: 5 * 21.0 / 23, End: 5},
+			},
+		},
+		{
+			name: "no limits",
+			seg:  seg("", "a b c d e f g h", 2, 30),
+			want: []caption{{Text: "a b c d e f g h", Start: 2, End: 30}},
+		},
+		{
+			name:     "negative duration is clamped",
+			maxChars: 84,
+			seg:      seg("", "late", 5, 4),
+			want:     []caption{{Text: "late", Start: 5, End: 5}},
+		},
+		{name: "empty", maxChars: 84, seg: seg("", "   ", 0, 1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := &Export{MaxChars: tt.maxChars, MaxDuration: tt.maxDuration}
+			got := e.splitSegment(tt.seg)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d captions %+v, want %d", len(got), got, len(tt.want))
+			}
+			for i, c := range got {
+				w := tt.want[i]
+				if c.Speaker != w.Speaker || c.Text != w.Text ||
+					math.Abs(c.Start-w.Start) > 1e-9 || math.Abs(c.End-w.End) > 1e-9 {
+					t.Errorf("caption %d = %+v, want %+v", i, c, w)
+				}
+			}
+		})
+	}
+}
+
+func TestFormatSubtitles(t *testing.T) {
+	captions := []caption{
+		{Speaker: "Ana", Text: "Welcome back.", Start: 0, End: 2.5},
+		{Text: "Use <b> & friends", Start: 3661.5, End: 3662.0004},
+		{Speaker: "<Ben>", Text: "ok", Start: -1, End: 0.001},
+	}
+
+	tests := []struct {
+		name     string
+		format   func([]caption) string
+		captions []caption
+		want     string
+	}{
+		{
+			name:     "srt",
+			format:   formatSRT,
+			captions: captions,
+			want: "1\n00:00:00,000 --> 00:00:02,500\nAna: Welcome back.\n\n" +
+				"2\n01:01:01,500 --> 01:01:02,000\nUse <b> & friends\n\n" +
+				"3\n00:00:00,000 --> 00:00:00,001\n<Ben>: ok\n\n",
+		},
+		{
+			name:     "vtt",
+			format:   formatVTT,
+			captions: captions,
+			want: "WEBVTT\n\n" +
+				"00:00:00.000 --> 00:00:02.500\n<v Ana>Welcome back.\n\n" +
+				"01:01:01.500 --> 01:01:02.000\nUse &lt;b&gt; &amp; friends\n\n" +
+				"00:00:00.000 --> 00:00:00.001\n<v &lt;Ben&gt;>ok\n\n",
+		},
+		{name: "srt empty", format: formatSRT, want: ""},
+		{name: "vtt empty", format: formatVTT, want: "WEBVTT\n\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.format(tt.captions); got != tt.want {
+				t.Errorf("got\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package usecases
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"text/template"
+	"time"
+)
+
+type FolderTemplateData struct {
+	Year, Month, Day, Hour, Minute, Second, Name string
+}
+
+// createMeetingDir renders the folder template for t and name and creates
+// the resulting directory under meetingsDir. created is false if the
+// directory already existed.
+func createMeetingDir(meetingsDir, folderTemplate string, t time.Time, name string) (meetingDir string, created bool, err error) {
+	dirName, err := renderFolderName(folderTemplate, t, name)
+	if err != nil {
+		return "", false, fmt.Errorf("rendering folder name: %w", err)
+	}
+	meetingDir = filepath.Join(meetingsDir, dirName)
+	_, statErr := os.Stat(meetingDir)
+	if err := os.MkdirAll(meetingDir, 0o755); err != nil {
+		return "", false, fmt.Errorf("creating meeting directory: %w", err)
+	}
+	return meetingDir, statErr != nil, nil
+}
+
+func renderFolderName(folderTemplate string, t time.Time, name string) (string, error) {
+	tmpl, err := template.New("folder").Parse(folderTemplate)
+	if err != nil {
+		return "", fmt.Errorf("invalid folder template: %w", err)
+	}
+
+	data := FolderTemplateData{
+		Year:   t.Format("2006"),
+		Month:  t.Format("01"),
+		Day:    t.Format("02"),
+		Hour:   t.Format("15"),
+		Minute: t.Format("04"),
+		Second: t.Format("05"),
+		Name:   name,
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("executing folder template: %w", err)
+	}
+	return buf.String(), nil
+}
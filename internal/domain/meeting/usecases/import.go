@@ -0,0 +1,97 @@
+package usecases
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/devbydaniel/meetingcli/internal/domain/meeting"
+)
+
+// Converter converts an arbitrary audio or video file to a 16kHz mono WAV.
+type Converter interface {
+	Check() error
+	ConvertAudio(inputPath, outputPath string) error
+}
+
+// Prober reads the duration of an audio file.
+type Prober interface {
+	Duration(path string) (time.Duration, error)
+}
+
+// Import creates a meeting from an existing audio file, e.g. a phone or
+// cloud recording that never went through Record.
+type Import struct {
+	Converter      Converter
+	Prober         Prober
+	Meetings       *meeting.Repository
+	MeetingsDir    string
+	FolderTemplate string
+}
+
+type ImportOptions struct {
+	Name      string
+	Attendees []string // hint for the summarizer
+	Template  string   // summary template, validated by the caller
+	Tags      []string
+	// StartedAt is when the meeting actually happened. Defaults to now.
+	StartedAt time.Time
+}
+
+// Execute creates the meeting folder and converts inputPath to recording.wav.
+func (i *Import) Execute(inputPath string, opts *ImportOptions) (*meeting.RecordingResult, error) {
+	if _, err := os.Stat(inputPath); err != nil {
+		return nil, fmt.Errorf("input file: %w", err)
+	}
+	if err := i.Converter.Check(); err != nil {
+		return nil, err
+	}
+
+	startedAt := opts.StartedAt
+	if startedAt.IsZero() {
+		startedAt = time.Now()
+	}
+
+	meetingDir, created, err := createMeetingDir(i.MeetingsDir, i.FolderTemplate, startedAt, opts.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	audioPath := filepath.Join(meetingDir, meeting.AudioFile)
+	if _, err := os.Stat(audioPath); err == nil {
+		return nil, fmt.Errorf("meeting %s already has a recording", filepath.Base(meetingDir))
+	}
+
+	// meeting.json is only written once the conversion succeeded, so a
+	// failed import leaves an existing meeting as it was. Only a folder
+	// created here is removed again.
+	if err := i.Converter.ConvertAudio(inputPath, audioPath); err != nil {
+		if created {
+			_ = os.RemoveAll(meetingDir)
+		} else {
+			_ = os.Remove(audioPath)
+		}
+		return nil, err
+	}
+
+	updateMeeting(i.Meetings, meetingDir, func(m *meeting.Meeting) {
+		m.Name = opts.Name
+		m.Attendees = opts.Attendees
+		m.Tags = opts.Tags
+		m.Template = opts.Template
+		m.StartedAt = startedAt
+		m.AudioBackend = "import"
+		m.Stages[meeting.StageRecord] = &meeting.StageState{Status: meeting.StatusDone, UpdatedAt: time.Now()}
+		if duration, err := i.Prober.Duration(audioPath); err == nil {
+			m.EndedAt = startedAt.Add(duration)
+			m.DurationSeconds = duration.Seconds()
+		}
+	})
+
+	return &meeting.RecordingResult{
+		StartedAt:  startedAt,
+		AudioPath:  audioPath,
+		MeetingDir: meetingDir,
+	}, nil
+}
@@ -0,0 +1,112 @@
+package usecases_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/devbydaniel/meetingcli/internal/audio"
+	"github.com/devbydaniel/meetingcli/internal/domain/meeting"
+	"github.com/devbydaniel/meetingcli/internal/domain/meeting/usecases"
+)
+
+// fakeConverter copies the input, or leaves a partial output and fails.
+type fakeConverter struct {
+	fail bool
+}
+
+func (c *fakeConverter) Check() error { return nil }
+
+func (c *fakeConverter) ConvertAudio(inputPath, outputPath string) error {
+	if c.fail {
+		_ = os.WriteFile(outputPath, []byte("partial"), 0o644)
+		return errors.New("conversion failed")
+	}
+	data, err := os.ReadFile(inputPath)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(outputPath, data, 0o644)
+}
+
+func TestImportExecute(t *testing.T) {
+	input := filepath.Join(t.TempDir(), "phone.wav")
+	writeFixture(t, input, 2*time.Second, 1000)
+	startedAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.Local)
+
+	newImport := func(meetingsDir string, fail bool) *usecases.Import {
+		return &usecases.Import{
+			Converter:      &fakeConverter{fail: fail},
+			Prober:         audio.NewWAVProber(),
+			Meetings:       meeting.NewRepository(meetingsDir),
+			MeetingsDir:    meetingsDir,
+			FolderTemplate: "{{.Name}}",
+		}
+	}
+	opts := &usecases.ImportOptions{Name: "standup", Tags: []string{"team"}, StartedAt: startedAt}
+
+	t.Run("success", func(t *testing.T) {
+		meetingsDir := t.TempDir()
+		result, err := newImport(meetingsDir, false).Execute(input, opts)
+		if err != nil {
+			t.Fatalf("Execute: %v", err)
+		}
+		m, err := meeting.NewRepository(meetingsDir).Load(result.MeetingDir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if m.Name != "standup" || m.AudioBackend != "import" || !slices.Equal(m.Tags, []string{"team"}) {
+			t.Errorf("meeting = %q (backend %q, tags %v)", m.Name, m.AudioBackend, m.Tags)
+		}
+		if !m.StartedAt.Equal(startedAt) || m.DurationSeconds != 2 {
+			t.Errorf("StartedAt = %v, DurationSeconds = %v", m.StartedAt, m.DurationSeconds)
+		}
+		if got := m.StageStatus(meeting.StageRecord); got != meeting.StatusDone {
+			t.Errorf("record stage = %q, want %q", got, meeting.StatusDone)
+		}
+	})
+
+	t.Run("failure removes new folder", func(t *testing.T) {
+		meetingsDir := t.TempDir()
+		if _, err := newImport(meetingsDir, true).Execute(input, opts); err == nil {
+			t.Fatal("Execute succeeded, want error")
+		}
+		if _, err := os.Stat(filepath.Join(meetingsDir, "standup")); !errors.Is(err, os.ErrNotExist) {
+			t.Errorf("meeting folder left behind: %v", err)
+		}
+	})
+
+	t.Run("failure keeps existing meeting", func(t *testing.T) {
+		meetingsDir := t.TempDir()
+		dir := filepath.Join(meetingsDir, "standup")
+		if err := os.Mkdir(dir, 0o755); err != nil {
+			t.Fatal(err)
+		}
+		repo := meeting.NewRepository(meetingsDir)
+		existing := &meeting.Meeting{Dir: dir, Name: "original", AudioBackend: "coreaudio", Stages: map[meeting.Stage]*meeting.StageState{}}
+		if err := repo.Save(existing); err != nil {
+			t.Fatal(err)
+		}
+		before, err := os.ReadFile(filepath.Join(dir, meeting.MetadataFile))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := newImport(meetingsDir, true).Execute(input, opts); err == nil {
+			t.Fatal("Execute succeeded, want error")
+		}
+		after, err := os.ReadFile(filepath.Join(dir, meeting.MetadataFile))
+		if err != nil {
+			t.Fatalf("meeting.json: %v", err)
+		}
+		if string(after) != string(before) {
+			t.Errorf("meeting.json changed:\n%s\nwant:\n%s", after, before)
+		}
+		if _, err := os.Stat(filepath.Join(dir, meeting.AudioFile)); !errors.Is(err, os.ErrNotExist) {
+			t.Errorf("partial recording left behind: %v", err)
+		}
+	})
+}
@@ -0,0 +1,216 @@
+package usecases
+
+import (
+	"cmp"
+	"fmt"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/devbydaniel/meetingcli/internal/domain/meeting"
+)
+
+// Statuses a listed meeting can be filtered by. A meeting is in every status
+// that applies, e.g. both failed and missing-summary.
+const (
+	StatusComplete          = "complete" // transcribed and summarized
+	StatusMissingTranscript = "missing-transcript"
+	StatusMissingSummary    = "missing-summary"
+	StatusMissingActions    = "missing-actions"
+	StatusFailed            = "failed"
+)
+
+// Sort orders for List.
+const (
+	SortDate     = "date"     // newest first
+	SortName     = "name"     // by folder name
+	SortDuration = "duration" // longest first
+)
+
+// folderTimeLayout is the date prefix of the default folder template, used
+// for meetings without a start time in meeting.json.
+const folderTimeLayout = "2006-01-02_15-04-05"
+
+// MeetingFilter selects and orders the meetings returned by List.
+type MeetingFilter struct {
+	Since, Until time.Time // start time bounds; zero is unbounded
+	// Name matches the meeting name or folder: a glob if it contains * ? or
+	// [, otherwise a case-insensitive substring.
+	Name   string
+	Tags   []string // all must be present
+	Status string   // one of the Status constants
+	Sort   string   // one of the Sort constants; default SortDate
+	Limit  int      // 0 for all
+}
+
+// MeetingInfo is a meeting as listed.
+type MeetingInfo struct {
+	Folder    string
+	Dir       string
+	Name      string
+	StartedAt time.Time // zero if unknown
+	Duration  time.Duration
+	Tags      []string
+	Status    string // the most relevant status: failed, missing-… or complete
+}
+
+// List queries the meetings in the meetings directory.
+type List struct {
+	Meetings *meeting.Repository
+	// Prober reads the duration of recordings that meeting.json doesn't
+	// have one for.
+	Prober Prober
+}
+
+// Execute returns the meetings matching filter.
+func (l *List) Execute(filter MeetingFilter) ([]MeetingInfo, error) {
+	var match func(string) bool
+	if pattern := strings.ToLower(filter.Name); strings.ContainsAny(pattern, "*?[") {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid name pattern %q: %w", filter.Name, err)
+		}
+		match = func(s string) bool {
+			ok, _ := path.Match(pattern, strings.ToLower(s))
+			return ok
+		}
+	} else {
+		match = func(s string) bool { return strings.Contains(strings.ToLower(s), pattern) }
+	}
+	if !slices.Contains([]string{"", StatusComplete, StatusMissingTranscript, StatusMissingSummary, StatusMissingActions, StatusFailed}, filter.Status) {
+		return nil, fmt.Errorf("unknown status %q (expected %s, %s, %s, %s or %s)", filter.Status,
+			StatusComplete, StatusMissingTranscript, StatusMissingSummary, StatusMissingActions, StatusFailed)
+	}
+
+	meetings, err := l.Meetings.List()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []MeetingInfo
+	for _, m := range meetings {
+		folder := filepath.Base(m.Dir)
+		started := startTime(m)
+		switch {
+		case !filter.Since.IsZero() && (started.IsZero() || started.Before(filter.Since)):
+			continue
+		case !filter.Until.IsZero() && (started.IsZero() || !started.Before(filter.Until)):
+			continue
+		case filter.Name != "" && !match(m.Name) && !match(folder):
+			continue
+		case !containsAll(m.Tags, filter.Tags):
+			continue
+		case filter.Status != "" && !hasStatus(m, filter.Status):
+			continue
+		}
+		result = append(result, l.info(m))
+	}
+
+	switch filter.Sort {
+	case SortDate, "":
+		slices.SortStableFunc(result, func(a, b MeetingInfo) int { return b.StartedAt.Compare(a.StartedAt) })
+	case SortName:
+		slices.SortStableFunc(result, func(a, b MeetingInfo) int { return cmp.Compare(a.Folder, b.Folder) })
+	case SortDuration:
+		slices.SortStableFunc(result, func(a, b MeetingInfo) int { return cmp.Compare(b.Duration, a.Duration) })
+	default:
+		return nil, fmt.Errorf("unknown sort order %q (expected %s, %s or %s)", filter.Sort, SortDate, SortName, SortDuration)
+	}
+
+	if filter.Limit > 0 && len(result) > filter.Limit {
+		result = result[:filter.Limit]
+	}
+	return result, nil
+}
+
+// Get returns the meeting in meetingDir as it would be listed.
+func (l *List) Get(meetingDir string) (*MeetingInfo, error) {
+	m, err := l.Meetings.Load(meetingDir)
+	if err != nil {
+		return nil, err
+	}
+	info := l.info(m)
+	return &info, nil
+}
+
+// Latest returns the directory of the most recent meeting.
+func (l *List) Latest() (string, error) {
+	meetings, err := l.Execute(MeetingFilter{Limit: 1})
+	if err != nil {
+		return "", err
+	}
+	if len(meetings) == 0 {
+		return "", fmt.Errorf("no meetings in %s", l.Meetings.MeetingsDir)
+	}
+	return meetings[0].Dir, nil
+}
+
+func (l *List) info(m *meeting.Meeting) MeetingInfo {
+	duration := m.Duration()
+	if duration == 0 && l.Prober != nil {
+		duration, _ = l.Prober.Duration(filepath.Join(m.Dir, meeting.AudioFile))
+	}
+	return MeetingInfo{
+		Folder:    filepath.Base(m.Dir),
+		Dir:       m.Dir,
+		Name:      m.Name,
+		StartedAt: startTime(m),
+		Duration:  duration,
+		Tags:      m.Tags,
+		Status:    meetingStatus(m),
+	}
+}
+
+// startTime returns when m started, falling back to the date in its folder
+// name. Zero if unknown.
+func startTime(m *meeting.Meeting) time.Time {
+	if !m.StartedAt.IsZero() {
+		return m.StartedAt
+	}
+	folder := filepath.Base(m.Dir)
+	if len(folder) < len(folderTimeLayout) {
+		return time.Time{}
+	}
+	t, _ := time.ParseInLocation(folderTimeLayout, folder[:len(folderTimeLayout)], time.Local)
+	return t
+}
+
+// hasStatus reports whether status applies to m.
+func hasStatus(m *meeting.Meeting, status string) bool {
+	switch status {
+	case StatusComplete:
+		return !m.Failed() && m.StageStatus(meeting.StageTranscribe) == meeting.StatusDone &&
+			m.StageStatus(meeting.StageSummarize) == meeting.StatusDone
+	case StatusMissingTranscript:
+		return m.StageStatus(meeting.StageTranscribe) != meeting.StatusDone
+	case StatusMissingSummary:
+		return m.StageStatus(meeting.StageSummarize) != meeting.StatusDone
+	case StatusMissingActions:
+		return m.StageStatus(meeting.StageActions) != meeting.StatusDone
+	case StatusFailed:
+		return m.Failed()
+	}
+	return false
+}
+
+// meetingStatus returns the first status that applies to m, in order of
+// relevance.
+func meetingStatus(m *meeting.Meeting) string {
+	for _, status := range []string{StatusFailed, StatusMissingTranscript, StatusMissingSummary} {
+		if hasStatus(m, status) {
+			return status
+		}
+	}
+	return StatusComplete
+}
+
+// containsAll reports whether have contains every element of want.
+func containsAll(have, want []string) bool {
+	for _, w := range want {
+		if !slices.Contains(have, w) {
+			return false
+		}
+	}
+	return true
+}
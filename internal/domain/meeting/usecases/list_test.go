@@ -0,0 +1,183 @@
+package usecases
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/devbydaniel/meetingcli/internal/domain/meeting"
+)
+
+// fakeProber returns the durations of recordings by meeting folder.
+type fakeProber map[string]time.Duration
+
+func (f fakeProber) Duration(path string) (time.Duration, error) {
+	return f[filepath.Base(filepath.Dir(path))], nil
+}
+
+// listFixture creates four meetings:
+//   - a complete standup
+//   - a planning meeting whose summary failed
+//   - an interview with no start time or duration in meeting.json and no
+//     action items
+//   - a folder without a date that was never transcribed
+func listFixture(t *testing.T) *List {
+	t.Helper()
+	dir := t.TempDir()
+	repo := meeting.NewRepository(dir)
+	done := &meeting.StageState{Status: meeting.StatusDone}
+	failed := &meeting.StageState{Status: meeting.StatusFailed, Error: "boom"}
+
+	for _, m := range []*meeting.Meeting{
+		{
+			Dir:             "2026-03-02_09-00-00_standup",
+			Name:            "Standup",
+			StartedAt:       time.Date(2026, 3, 2, 9, 0, 0, 0, time.Local),
+			DurationSeconds: 900,
+			Tags:            []string{"team"},
+			Stages:          map[meeting.Stage]*meeting.StageState{meeting.StageTranscribe: done, meeting.StageSummarize: done, meeting.StageActions: done},
+		},
+		{
+			Dir:             "2026-03-05_14-00-00_planning",
+			Name:            "Q2 Planning",
+			StartedAt:       time.Date(2026, 3, 5, 14, 0, 0, 0, time.Local),
+			DurationSeconds: 3600,
+			Tags:            []string{"team", "product"},
+			Stages:          map[meeting.Stage]*meeting.StageState{meeting.StageTranscribe: done, meeting.StageSummarize: failed},
+		},
+		{
+			Dir:    "2026-03-10_16-30-00_interview",
+			Name:   "Interview",
+			Tags:   []string{"hiring"},
+			Stages: map[meeting.Stage]*meeting.StageState{meeting.StageTranscribe: done, meeting.StageSummarize: done},
+		},
+		{
+			Dir:    "notes",
+			Name:   "Notes",
+			Stages: map[meeting.Stage]*meeting.StageState{},
+		},
+	} {
+		m.Dir = filepath.Join(dir, m.Dir)
+		if err := os.Mkdir(m.Dir, 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := repo.Save(m); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return &List{Meetings: repo, Prober: fakeProber{"2026-03-10_16-30-00_interview": 30 * time.Minute}}
+}
+
+func TestListExecute(t *testing.T) {
+	const (
+		standup   = "2026-03-02_09-00-00_standup"
+		planning  = "2026-03-05_14-00-00_planning"
+		interview = "2026-03-10_16-30-00_interview"
+		notes     = "notes"
+	)
+
+	tests := []struct {
+		name    string
+		filter  MeetingFilter
+		want    []string
+		wantErr bool
+	}{
+		{name: "no filter", want: []string{interview, planning, standup, notes}},
+		{
+			name:   "since",
+			filter: MeetingFilter{Since: time.Date(2026, 3, 3, 0, 0, 0, 0, time.Local)},
+			want:   []string{interview, planning},
+		},
+		{
+			name:   "until is exclusive",
+			filter: MeetingFilter{Until: time.Date(2026, 3, 5, 14, 0, 0, 0, time.Local)},
+			want:   []string{standup},
+		},
+		{
+			name: "since and until",
+			filter: MeetingFilter{
+				Since: time.Date(2026, 3, 5, 0, 0, 0, 0, time.Local),
+				Until: time.Date(2026, 3, 6, 0, 0, 0, 0, time.Local),
+			},
+			want: []string{planning},
+		},
+		{name: "name substring", filter: MeetingFilter{Name: "PLAN"}, want: []string{planning}},
+		{name: "folder glob", filter: MeetingFilter{Name: "2026-03-0*"}, want: []string{planning, standup}},
+		{name: "name glob", filter: MeetingFilter{Name: "*view"}, want: []string{interview}},
+		{name: "no match", filter: MeetingFilter{Name: "retro"}, want: nil},
+		{name: "tag", filter: MeetingFilter{Tags: []string{"team"}}, want: []string{planning, standup}},
+		{name: "all tags", filter: MeetingFilter{Tags: []string{"team", "product"}}, want: []string{planning}},
+		{name: "complete", filter: MeetingFilter{Status: StatusComplete}, want: []string{interview, standup}},
+		{name: "failed", filter: MeetingFilter{Status: StatusFailed}, want: []string{planning}},
+		{name: "missing transcript", filter: MeetingFilter{Status: StatusMissingTranscript}, want: []string{notes}},
+		{name: "missing summary", filter: MeetingFilter{Status: StatusMissingSummary}, want: []string{planning, notes}},
+		{name: "missing actions", filter: MeetingFilter{Status: StatusMissingActions}, want: []string{interview, planning, notes}},
+		{name: "sort by name", filter: MeetingFilter{Sort: SortName}, want: []string{standup, planning, interview, notes}},
+		{name: "sort by duration", filter: MeetingFilter{Sort: SortDuration}, want: []string{planning, interview, standup, notes}},
+		{name: "limit", filter: MeetingFilter{Limit: 2}, want: []string{interview, planning}},
+		{
+			name:   "combined",
+			filter: MeetingFilter{Tags: []string{"team"}, Status: StatusComplete, Sort: SortDuration},
+			want:   []string{standup},
+		},
+		{name: "unknown status", filter: MeetingFilter{Status: "archived"}, wantErr: true},
+		{name: "unknown sort", filter: MeetingFilter{Sort: "size"}, wantErr: true},
+		{name: "invalid glob", filter: MeetingFilter{Name: "[plan"}, wantErr: true},
+	}
+
+	list := listFixture(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := list.Execute(tt.filter)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("Execute succeeded, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+			var folders []string
+			for _, info := range got {
+				folders = append(folders, info.Folder)
+			}
+			if !slices.Equal(folders, tt.want) {
+				t.Errorf("meetings = %v, want %v", folders, tt.want)
+			}
+		})
+	}
+}
+
+func TestListInfo(t *testing.T) {
+	list := listFixture(t)
+	got, err := list.Execute(MeetingFilter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]struct {
+		started  time.Time
+		duration time.Duration
+		status   string
+	}{
+		"2026-03-02_09-00-00_standup":   {time.Date(2026, 3, 2, 9, 0, 0, 0, time.Local), 15 * time.Minute, StatusComplete},
+		"2026-03-05_14-00-00_planning":  {time.Date(2026, 3, 5, 14, 0, 0, 0, time.Local), time.Hour, StatusFailed},
+		"2026-03-10_16-30-00_interview": {time.Date(2026, 3, 10, 16, 30, 0, 0, time.Local), 30 * time.Minute, StatusComplete},
+		"notes":                         {time.Time{}, 0, StatusMissingTranscript},
+	}
+	for _, info := range got {
+		w := want[info.Folder]
+		if !info.StartedAt.Equal(w.started) {
+			t.Errorf("%s: StartedAt = %v, want %v", info.Folder, info.StartedAt, w.started)
+		}
+		if info.Duration != w.duration {
+			t.Errorf("%s: Duration = %v, want %v", info.Folder, info.Duration, w.duration)
+		}
+		if info.Status != w.status {
+			t.Errorf("%s: Status = %q, want %q", info.Folder, info.Status, w.status)
+		}
+	}
+}
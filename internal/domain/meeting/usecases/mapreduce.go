@@ -0,0 +1,108 @@
+package usecases
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+const partSystemPrompt = `You are taking notes on one part of a long meeting transcript. Write concise but complete notes in markdown: topics discussed, decisions, action items with owners and due dates, and open questions, citing [hh:mm:ss] timestamps where useful. The notes will be combined with those of the other parts into one summary, so don't add an introduction or conclusion.`
+
+// estimateTokens approximates the number of tokens in s. Four characters per
+// token is a common rule of thumb for English text across tokenizers.
+func estimateTokens(s string) int {
+	return (utf8.RuneCountInString(s) + 3) / 4
+}
+
+// partsPrompt condenses a transcript that is over the token budget: each
+// part (split on speaker turns) is turned into notes, and the notes are
+// returned as the prompt for the final summaries.
+func (s *Summarize) partsPrompt(hint string, lines []string) (string, error) {
+	parts := packChunks(lines, s.TokenBudget)
+	notes := make([]string, len(parts))
+	for i, part := range parts {
+		prompt := fmt.Sprintf("This is part %d of %d of the meeting transcript.\n\n%s%s", i+1, len(parts), hint, part)
+		note, err := s.Summarizer.Summarize(partSystemPrompt, prompt)
+		if err != nil {
+			return "", fmt.Errorf("summarizing part %d of %d: %w", i+1, len(parts), err)
+		}
+		notes[i] = fmt.Sprintf("## Part %d of %d\n\n%s", i+1, len(parts), note)
+	}
+
+	// The notes of a very long meeting may be over budget themselves;
+	// condense neighbouring notes until they fit.
+	for len(notes) > 1 && estimateTokens(strings.Join(notes, "\n\n")) > s.TokenBudget {
+		groups := packChunks(notes, s.TokenBudget)
+		if len(groups) == len(notes) {
+			break // no two notes fit together; send them as they are
+		}
+		condensed := make([]string, len(groups))
+		for i, group := range groups {
+			note, err := s.Summarizer.Summarize(partSystemPrompt, "These are notes on consecutive parts of a meeting; merge them into one set of notes.\n\n"+group)
+			if err != nil {
+				return "", fmt.Errorf("condensing notes: %w", err)
+			}
+			condensed[i] = fmt.Sprintf("## Part %d of %d\n\n%s", i+1, len(groups), note)
+		}
+		notes = condensed
+	}
+
+	return "The meeting transcript was too long to summarize at once. Here are notes on its consecutive parts; summarize them as one meeting:\n\n" +
+		hint + strings.Join(notes, "\n\n"), nil
+}
+
+// packChunks joins consecutive items (speaker turns, or notes) into chunks
+// of at most budget tokens. An item over budget on its own is split at word
+// boundaries.
+func packChunks(items []string, budget int) []string {
+	var chunks []string
+	var current strings.Builder
+	tokens := 0
+	flush := func() {
+		if current.Len() > 0 {
+			chunks = append(chunks, current.String())
+			current.Reset()
+			tokens = 0
+		}
+	}
+
+	for _, item := range items {
+		for _, piece := range splitWords(item, budget) {
+			pieceTokens := estimateTokens(piece) + 1
+			if current.Len() > 0 && tokens+pieceTokens > budget {
+				flush()
+			}
+			if current.Len() > 0 {
+				current.WriteString("\n")
+			}
+			current.WriteString(piece)
+			tokens += pieceTokens
+		}
+	}
+	flush()
+	return chunks
+}
+
+// splitWords splits s at word boundaries into pieces of at most budget tokens.
+func splitWords(s string, budget int) []string {
+	if estimateTokens(s) <= budget {
+		return []string{s}
+	}
+
+	var pieces []string
+	var current []string
+	currentLen := 0
+	for _, word := range strings.Fields(s) {
+		wordLen := utf8.RuneCountInString(word) + 1
+		if len(current) > 0 && (currentLen+wordLen+3)/4 > budget {
+			pieces = append(pieces, strings.Join(current, " "))
+			current, currentLen = nil, 0
+		}
+		current = append(current, word)
+		currentLen += wordLen
+	}
+	if len(current) > 0 {
+		pieces = append(pieces, strings.Join(current, " "))
+	}
+	return pieces
+}
@@ -0,0 +1,69 @@
+package usecases
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestSplitWords(t *testing.T) {
+	tests := []struct {
+		name   string
+		s      string
+		budget int
+		want   []string
+	}{
+		{name: "fits", s: "short  text", budget: 10, want: []string{"short  text"}},
+		{name: "split at words", s: "aaa bbb ccc ddd", budget: 2, want: []string{"aaa bbb", "ccc ddd"}},
+		{name: "long word stays whole", s: "abcdefghijklmnop x", budget: 1, want: []string{"abcdefghijklmnop", "x"}},
+		{name: "runes not bytes", s: "äöü äöü äöü äöü", budget: 2, want: []string{"äöü äöü", "äöü äöü"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := splitWords(tt.s, tt.budget); !slices.Equal(got, tt.want) {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPackChunks(t *testing.T) {
+	long := strings.Repeat("word ", 40) // 50 tokens
+	tests := []struct {
+		name   string
+		items  []string
+		budget int
+		want   []string
+	}{
+		{name: "nothing", budget: 10},
+		{name: "all fit", items: []string{"aaaa", "bbbb"}, budget: 10, want: []string{"aaaa\nbbbb"}},
+		{name: "packed in order", items: []string{"aaaa", "bbbb", "cccc"}, budget: 5, want: []string{"aaaa\nbbbb", "cccc"}},
+		{name: "one per chunk", items: []string{"aaaa bbbb", "cccc dddd"}, budget: 4, want: []string{"aaaa bbbb", "cccc dddd"}},
+		{
+			name:   "oversized item is split",
+			items:  []string{"A: hi", long, "B: bye"},
+			budget: 20,
+			want: []string{
+				"A: hi",
+				strings.TrimSpace(strings.Repeat("word ", 16)),
+				strings.TrimSpace(strings.Repeat("word ", 16)),
+				strings.TrimSpace(strings.Repeat("word ", 8)) + "\nB: bye",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := packChunks(tt.items, tt.budget)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+			for _, chunk := range got {
+				if tokens := estimateTokens(chunk); tokens > tt.budget {
+					t.Errorf("chunk of %d tokens is over budget %d", tokens, tt.budget)
+				}
+			}
+		})
+	}
+}
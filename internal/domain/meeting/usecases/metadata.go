@@ -0,0 +1,49 @@
+package usecases
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/devbydaniel/meetingcli/internal/domain/meeting"
+)
+
+// trackStage marks stage as running in meeting.json, runs fn, and records
+// the outcome. Manifest errors are reported as warnings and never fail the
+// stage itself. A nil repo disables tracking.
+func trackStage(repo *meeting.Repository, dir string, stage meeting.Stage, fn func() error) error {
+	if repo == nil {
+		return fn()
+	}
+	warnMetadata(repo.StartStage(dir, stage))
+	err := fn()
+	warnMetadata(repo.FinishStage(dir, stage, err))
+	return err
+}
+
+// updateMeeting applies fn to the manifest in dir, warning on failure.
+func updateMeeting(repo *meeting.Repository, dir string, fn func(m *meeting.Meeting)) {
+	if repo == nil {
+		return
+	}
+	warnMetadata(repo.Update(dir, fn))
+}
+
+// loadMeeting returns the manifest in dir, or an empty one (with a warning
+// on failure) when it can't be loaded. A nil repo yields an empty manifest.
+func loadMeeting(repo *meeting.Repository, dir string) *meeting.Meeting {
+	if repo == nil {
+		return &meeting.Meeting{}
+	}
+	m, err := repo.Load(dir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "warning: could not read %s: %v\n", meeting.MetadataFile, err)
+		return &meeting.Meeting{}
+	}
+	return m
+}
+
+func warnMetadata(err error) {
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "warning: could not update %s: %v\n", meeting.MetadataFile, err)
+	}
+}
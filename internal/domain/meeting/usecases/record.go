@@ -1,60 +1,136 @@
 package usecases
 
 import (
-	"bytes"
 	"fmt"
 	"os"
 	"os/signal"
 	"path/filepath"
+	"slices"
 	"syscall"
-	"text/template"
 	"time"
 
-	"github.com/devbydaniel/meetingcli/internal/audio"
 	"github.com/devbydaniel/meetingcli/internal/domain/meeting"
 )
 
+// Capturer captures system audio to a WAV file in the background.
+type Capturer interface {
+	// StartCapture begins capturing. With segment > 0, outputPath is a %04d
+	// pattern and a new file is started every segment.
+	StartCapture(outputPath string, segment time.Duration) error
+	StopCapture()
+}
+
+// MicRecorder records the microphone to a WAV file.
+type MicRecorder interface {
+	// Check reports whether the recorder can run (e.g. ffmpeg is installed).
+	Check() error
+	// RecordMic blocks until recording stops (e.g. SIGINT) or the source ends.
+	// Segmenting works as in Capturer.StartCapture.
+	RecordMic(outputPath string, segment time.Duration) error
+	// Stop ends a running RecordMic cleanly.
+	Stop()
+}
+
+// Merger combines system and mic audio into a single WAV file.
+type Merger interface {
+	MergeAudio(systemPath, micPath, outputPath string) error
+}
+
+// Stitcher concatenates recording segments into a single WAV file. Segments
+// that can't be read are skipped (and returned) rather than failing the
+// whole track.
+type Stitcher interface {
+	Stitch(segmentPaths []string, outputPath string, segment time.Duration) ([]string, error)
+}
+
 // Record handles recording a meeting. Foreground only — blocks until Ctrl+C.
 type Record struct {
-	Capturer       *audio.SystemAudioCapturer
-	Recorder       *audio.Recorder
+	Capturer Capturer
+	Recorder MicRecorder
+	Merger   Merger
+	Stitcher Stitcher
+	Prober   Prober
+	Meetings *meeting.Repository
+	// Segment rotates the capture files (mic-0001.wav, ...) every Segment.
+	// Zero records a single file per track.
+	Segment        time.Duration
+	Backend        string // recorded in meeting.json
 	MeetingsDir    string
 	FolderTemplate string
 }
 
 type RecordOptions struct {
-	Name string
-}
-
-type FolderTemplateData struct {
-	Year, Month, Day, Hour, Minute, Second, Name string
+	Name      string
+	Attendees []string // hint for the summarizer
+	Template  string   // summary template, validated by the caller
+	Tags      []string
 }
 
 // Execute runs a recording session. Blocks until interrupted (Ctrl+C).
 // Returns the result with paths to the merged audio and meeting dir.
 func (r *Record) Execute(opts *RecordOptions) (*meeting.RecordingResult, error) {
-	if err := r.Recorder.CheckFFmpeg(); err != nil {
+	if err := r.Recorder.Check(); err != nil {
 		return nil, err
 	}
 
 	// Create meeting directory
 	now := time.Now()
-	dirName, err := r.renderFolderName(now, opts.Name)
+	meetingDir, _, err := createMeetingDir(r.MeetingsDir, r.FolderTemplate, now, opts.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	updateMeeting(r.Meetings, meetingDir, func(m *meeting.Meeting) {
+		m.Name = opts.Name
+		m.Attendees = opts.Attendees
+		m.Tags = opts.Tags
+		m.Template = opts.Template
+		m.StartedAt = now
+		m.AudioBackend = r.Backend
+	})
+
+	var audioPath string
+	err = trackStage(r.Meetings, meetingDir, meeting.StageRecord, func() error {
+		var err error
+		audioPath, err = r.record(meetingDir)
+		return err
+	})
 	if err != nil {
-		return nil, fmt.Errorf("rendering folder name: %w", err)
+		return nil, err
 	}
-	meetingDir := filepath.Join(r.MeetingsDir, dirName)
-	if err := os.MkdirAll(meetingDir, 0o755); err != nil {
-		return nil, fmt.Errorf("creating meeting directory: %w", err)
+
+	// Prefer the length of the audio over wall-clock time (they differ for replayed files).
+	endedAt := time.Now()
+	duration := endedAt.Sub(now)
+	if d, err := r.Prober.Duration(audioPath); err == nil {
+		duration = d
 	}
+	updateMeeting(r.Meetings, meetingDir, func(m *meeting.Meeting) {
+		m.EndedAt = endedAt
+		m.DurationSeconds = duration.Seconds()
+	})
+
+	return &meeting.RecordingResult{
+		StartedAt:  now,
+		AudioPath:  audioPath,
+		MeetingDir: meetingDir,
+	}, nil
+}
 
-	micPath := filepath.Join(meetingDir, "mic.wav")
-	systemPath := filepath.Join(meetingDir, "system.wav")
-	audioPath := filepath.Join(meetingDir, "recording.wav")
+// record captures system and mic audio into meetingDir until interrupted,
+// then merges them. Returns the path of the merged recording.
+func (r *Record) record(meetingDir string) (string, error) {
+	micPath := filepath.Join(meetingDir, meeting.MicFile)
+	systemPath := filepath.Join(meetingDir, meeting.SystemFile)
+	audioPath := filepath.Join(meetingDir, meeting.AudioFile)
+	if r.Segment > 0 {
+		micPath = meeting.SegmentPattern(meetingDir, meeting.TrackMic)
+		systemPath = meeting.SegmentPattern(meetingDir, meeting.TrackSystem)
+	}
 
 	// Start system audio capture (cgo, streams to disk)
-	if err := r.Capturer.StartCapture(systemPath); err != nil {
-		return nil, err
+	if err := r.Capturer.StartCapture(systemPath, r.Segment); err != nil {
+		return "", err
 	}
 
 	// Record mic in foreground — blocks until SIGINT
@@ -64,59 +140,82 @@ func (r *Record) Execute(opts *RecordOptions) (*meeting.RecordingResult, error)
 
 	micDone := make(chan error, 1)
 	go func() {
-		micDone <- r.Recorder.RecordMic(micPath)
+		micDone <- r.Recorder.RecordMic(micPath, r.Segment)
 	}()
 
 	// Wait for interrupt or mic to stop
+	micStopped := false
 	select {
 	case <-sigCh:
 		// User pressed Ctrl+C
 	case <-micDone:
-		// ffmpeg exited on its own (unlikely)
+		// ffmpeg exited on its own, or a replay source ended
+		micStopped = true
 	}
 	signal.Stop(sigCh)
 
 	// Stop system audio capture and finalize WAV
 	r.Capturer.StopCapture()
 
-	// Wait for ffmpeg to finish (it also gets the SIGINT)
-	<-micDone
-
-	// Merge system + mic into recording.wav
-	if err := r.Recorder.MergeAudio(systemPath, micPath, audioPath); err != nil {
-		// Fall back to mic-only
-		fmt.Fprintf(os.Stderr, "warning: could not merge audio: %v\n", err)
-		if _, statErr := os.Stat(micPath); statErr == nil {
-			_ = os.Rename(micPath, audioPath)
-		}
+	// Wait for ffmpeg to finish (it also gets the SIGINT from the terminal,
+	// but not a SIGTERM sent to us alone)
+	if !micStopped {
+		r.Recorder.Stop()
+		<-micDone
 	}
 
-	return &meeting.RecordingResult{
-		StartedAt:  now,
-		AudioPath:  audioPath,
-		MeetingDir: meetingDir,
-	}, nil
+	stitchTracks(r.Stitcher, meetingDir, r.Segment)
+	mergeRecording(r.Merger, meetingDir)
+	return audioPath, nil
 }
 
-func (r *Record) renderFolderName(t time.Time, name string) (string, error) {
-	tmpl, err := template.New("folder").Parse(r.FolderTemplate)
-	if err != nil {
-		return "", fmt.Errorf("invalid folder template: %w", err)
-	}
+// stitchTracks concatenates the segment files of each track in meetingDir
+// into mic.wav and system.wav. Segments are removed once their track is
+// stitched; if stitching fails they are kept for `meeting recover`.
+func stitchTracks(stitcher Stitcher, meetingDir string, segment time.Duration) {
+	for _, track := range []string{meeting.TrackMic, meeting.TrackSystem} {
+		segments := meeting.SegmentFiles(meetingDir, track)
+		if len(segments) == 0 {
+			continue
+		}
+
+		trackPath := filepath.Join(meetingDir, track+".wav")
+		skipped, err := stitcher.Stitch(segments, trackPath, segment)
+		for _, path := range skipped {
+			fmt.Fprintf(os.Stderr, "warning: skipped unreadable segment %s\n", filepath.Base(path))
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "warning: could not stitch %s segments: %v\n", track, err)
+			_ = os.Remove(trackPath)
+			continue
+		}
 
-	data := FolderTemplateData{
-		Year:   t.Format("2006"),
-		Month:  t.Format("01"),
-		Day:    t.Format("02"),
-		Hour:   t.Format("15"),
-		Minute: t.Format("04"),
-		Second: t.Format("05"),
-		Name:   name,
+		// Unreadable segments are set aside for manual salvage.
+		for _, path := range segments {
+			if slices.Contains(skipped, path) {
+				_ = os.Rename(path, path+".broken")
+			} else {
+				_ = os.Remove(path)
+			}
+		}
 	}
+}
+
+// mergeRecording merges system.wav and mic.wav in meetingDir into
+// recording.wav, falling back to a single track if the merge fails.
+func mergeRecording(merger Merger, meetingDir string) {
+	micPath := filepath.Join(meetingDir, meeting.MicFile)
+	systemPath := filepath.Join(meetingDir, meeting.SystemFile)
+	audioPath := filepath.Join(meetingDir, meeting.AudioFile)
 
-	var buf bytes.Buffer
-	if err := tmpl.Execute(&buf, data); err != nil {
-		return "", fmt.Errorf("executing folder template: %w", err)
+	if err := merger.MergeAudio(systemPath, micPath, audioPath); err != nil {
+		// Fall back to mic-only, or system-only if the mic track is gone
+		fmt.Fprintf(os.Stderr, "warning: could not merge audio: %v\n", err)
+		for _, path := range []string{micPath, systemPath} {
+			if _, statErr := os.Stat(path); statErr == nil {
+				_ = os.Rename(path, audioPath)
+				return
+			}
+		}
 	}
-	return buf.String(), nil
 }
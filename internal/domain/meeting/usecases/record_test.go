@@ -0,0 +1,149 @@
+package usecases_test
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/devbydaniel/meetingcli/internal/audio"
+	"github.com/devbydaniel/meetingcli/internal/domain/meeting"
+	"github.com/devbydaniel/meetingcli/internal/domain/meeting/usecases"
+)
+
+// testFormat keeps the fixtures small: one second is 8000 samples.
+var testFormat = audio.Format{SampleRate: 8000, Channels: 1, BitsPerSample: 16}
+
+// writeFixture writes a WAV of d seconds where every sample is value.
+func writeFixture(t *testing.T, path string, d time.Duration, value int16) {
+	t.Helper()
+	samples := make([]int16, int(d.Seconds()*float64(testFormat.SampleRate)))
+	for i := range samples {
+		samples[i] = value
+	}
+	w, err := audio.CreateWAV(path, testFormat)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Write(samples); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// readSamples returns all samples of the WAV at path.
+func readSamples(t *testing.T, path string) []int16 {
+	t.Helper()
+	r, err := audio.OpenWAV(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	samples := make([]int16, r.Frames()*int64(r.Format.Channels))
+	n, err := r.Read(samples)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return samples[:n]
+}
+
+func TestRecordExecute(t *testing.T) {
+	tests := []struct {
+		name    string
+		meeting string
+		segment time.Duration
+	}{
+		{name: "single file", meeting: "standup", segment: 0},
+		{name: "segmented", meeting: "standup", segment: time.Second},
+		{name: "segmented with glob characters", meeting: "[ACME] sync", segment: time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fixtures := t.TempDir()
+			systemSrc := filepath.Join(fixtures, "system.wav")
+			micSrc := filepath.Join(fixtures, "mic.wav")
+			writeFixture(t, systemSrc, 1500*time.Millisecond, 1000)
+			writeFixture(t, micSrc, time.Second, 3000)
+
+			meetingsDir := t.TempDir()
+			repo := meeting.NewRepository(meetingsDir)
+			record := &usecases.Record{
+				Capturer:       audio.NewFileCapturer(systemSrc),
+				Recorder:       audio.NewFileMicRecorder(micSrc),
+				Merger:         audio.NewWAVMixer(),
+				Stitcher:       audio.NewWAVStitcher(),
+				Prober:         audio.NewWAVProber(),
+				Meetings:       repo,
+				Segment:        tt.segment,
+				Backend:        "file",
+				MeetingsDir:    meetingsDir,
+				FolderTemplate: "{{.Name}}",
+			}
+
+			result, err := record.Execute(&usecases.RecordOptions{
+				Name:      tt.meeting,
+				Attendees: []string{"Ana", "Ben"},
+				Tags:      []string{"team"},
+			})
+			if err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+
+			wantDir := filepath.Join(meetingsDir, tt.meeting)
+			if result.MeetingDir != wantDir {
+				t.Errorf("MeetingDir = %q, want %q", result.MeetingDir, wantDir)
+			}
+			if result.AudioPath != filepath.Join(wantDir, meeting.AudioFile) {
+				t.Errorf("AudioPath = %q", result.AudioPath)
+			}
+
+			// The mic is averaged with the system track and padded with
+			// silence after it ends.
+			samples := readSamples(t, result.AudioPath)
+			if len(samples) != 12000 {
+				t.Fatalf("recording.wav has %d samples, want 12000", len(samples))
+			}
+			if samples[0] != 2000 || samples[7999] != 2000 {
+				t.Errorf("mixed samples = %d, %d, want 2000", samples[0], samples[7999])
+			}
+			if samples[8000] != 500 || samples[11999] != 500 {
+				t.Errorf("system-only samples = %d, %d, want 500", samples[8000], samples[11999])
+			}
+
+			for _, track := range []string{meeting.TrackMic, meeting.TrackSystem} {
+				if segments := meeting.SegmentFiles(wantDir, track); len(segments) > 0 {
+					t.Errorf("%s segments left behind: %v", track, segments)
+				}
+			}
+			for _, name := range []string{meeting.MicFile, meeting.SystemFile} {
+				if _, err := os.Stat(filepath.Join(wantDir, name)); err != nil {
+					t.Errorf("track %s: %v", name, err)
+				}
+			}
+
+			m, err := repo.Load(wantDir)
+			if err != nil {
+				t.Fatalf("loading meeting.json: %v", err)
+			}
+			if m.Name != tt.meeting || m.AudioBackend != "file" {
+				t.Errorf("meeting = %q (backend %q)", m.Name, m.AudioBackend)
+			}
+			if !slices.Equal(m.Attendees, []string{"Ana", "Ben"}) || !slices.Equal(m.Tags, []string{"team"}) {
+				t.Errorf("attendees = %v, tags = %v", m.Attendees, m.Tags)
+			}
+			if m.DurationSeconds != 1.5 {
+				t.Errorf("DurationSeconds = %v, want 1.5", m.DurationSeconds)
+			}
+			if m.StartedAt.IsZero() || m.EndedAt.Before(m.StartedAt) {
+				t.Errorf("StartedAt = %v, EndedAt = %v", m.StartedAt, m.EndedAt)
+			}
+			if got := m.StageStatus(meeting.StageRecord); got != meeting.StatusDone {
+				t.Errorf("record stage = %q, want %q", got, meeting.StatusDone)
+			}
+		})
+	}
+}
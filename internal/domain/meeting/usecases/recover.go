@@ -0,0 +1,164 @@
+package usecases
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/devbydaniel/meetingcli/internal/domain/meeting"
+)
+
+// activeRecordingWindow is how recently a WAV must have been written to for
+// its meeting to be considered still recording.
+const activeRecordingWindow = 30 * time.Second
+
+// Repairer fixes WAV files whose header was never finalized.
+type Repairer interface {
+	NeedsRepair(path string) (bool, error)
+	Repair(path string) error
+}
+
+// Recover salvages meetings whose recording was interrupted (killed process,
+// sleep, panic): it repairs the WAV headers and merges the tracks.
+type Recover struct {
+	Repairer Repairer
+	Merger   Merger
+	Stitcher Stitcher
+	Prober   Prober
+	Meetings *meeting.Repository
+	Segment  time.Duration // segment length used when recording
+}
+
+// Find returns the meetings that need recovery.
+func (r *Recover) Find() ([]*meeting.Meeting, error) {
+	meetings, err := r.Meetings.List()
+	if err != nil {
+		return nil, err
+	}
+
+	var found []*meeting.Meeting
+	for _, m := range meetings {
+		if r.needsRecovery(m) && !isRecording(m.Dir) {
+			found = append(found, m)
+		}
+	}
+	return found, nil
+}
+
+// Execute repairs and merges the recording in meetingDir.
+func (r *Recover) Execute(meetingDir string) (*meeting.RecordingResult, error) {
+	if isRecording(meetingDir) {
+		return nil, fmt.Errorf("meeting %s is still being recorded", filepath.Base(meetingDir))
+	}
+
+	m, err := r.Meetings.Load(meetingDir)
+	if err != nil {
+		return nil, err
+	}
+
+	err = trackStage(r.Meetings, meetingDir, meeting.StageRecord, func() error {
+		return r.recover(m)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if duration, err := r.Prober.Duration(m.AudioPath); err == nil {
+		updateMeeting(r.Meetings, meetingDir, func(m *meeting.Meeting) {
+			if !m.StartedAt.IsZero() {
+				m.EndedAt = m.StartedAt.Add(duration)
+			}
+			m.DurationSeconds = duration.Seconds()
+		})
+	}
+
+	return &meeting.RecordingResult{
+		StartedAt:  m.StartedAt,
+		AudioPath:  m.AudioPath,
+		MeetingDir: meetingDir,
+	}, nil
+}
+
+func (r *Recover) recover(m *meeting.Meeting) error {
+	stitched := len(segmentFiles(m.Dir)) > 0
+	stitchTracks(r.Stitcher, m.Dir, r.Segment)
+
+	// Set aside tracks beyond repair so the other one still gets used.
+	for _, path := range recordingTracks(m.Dir) {
+		if err := r.Repairer.Repair(path); err != nil {
+			fmt.Fprintf(os.Stderr, "warning: could not repair %s, skipping it: %v\n", filepath.Base(path), err)
+			_ = os.Rename(path, path+".broken")
+		}
+	}
+
+	// A recording.wav from an interrupted merge, or merged before the
+	// segments were stitched, is incomplete: redo it.
+	if stitched || m.StageStatus(meeting.StageRecord) != meeting.StatusDone || !fileExists(m.AudioPath) {
+		if len(recordingTracks(m.Dir)) > 0 {
+			_ = os.Remove(m.AudioPath)
+			mergeRecording(r.Merger, m.Dir)
+		}
+	} else if err := r.Repairer.Repair(m.AudioPath); err != nil {
+		return fmt.Errorf("repairing %s: %w", meeting.AudioFile, err)
+	}
+
+	if !fileExists(m.AudioPath) {
+		return fmt.Errorf("no audio to recover in %s", m.Dir)
+	}
+	return nil
+}
+
+// needsRecovery reports whether the recording in m was never finalized.
+func (r *Recover) needsRecovery(m *meeting.Meeting) bool {
+	if len(segmentFiles(m.Dir)) > 0 {
+		return true
+	}
+	tracks := recordingTracks(m.Dir)
+	if len(tracks) == 0 {
+		return false
+	}
+	if m.StageStatus(meeting.StageRecord) != meeting.StatusDone || !fileExists(m.AudioPath) {
+		return true
+	}
+	for _, path := range append(tracks, m.AudioPath) {
+		if broken, err := r.Repairer.NeedsRepair(path); err != nil || broken {
+			return true
+		}
+	}
+	return false
+}
+
+// recordingTracks returns the mic and system WAVs present in meetingDir.
+func recordingTracks(meetingDir string) []string {
+	var tracks []string
+	for _, name := range []string{meeting.SystemFile, meeting.MicFile} {
+		if path := filepath.Join(meetingDir, name); fileExists(path) {
+			tracks = append(tracks, path)
+		}
+	}
+	return tracks
+}
+
+// segmentFiles returns the unstitched segment files of all tracks in meetingDir.
+func segmentFiles(meetingDir string) []string {
+	return append(
+		meeting.SegmentFiles(meetingDir, meeting.TrackMic),
+		meeting.SegmentFiles(meetingDir, meeting.TrackSystem)...,
+	)
+}
+
+// isRecording reports whether a track in meetingDir is still being written.
+func isRecording(meetingDir string) bool {
+	for _, path := range append(recordingTracks(meetingDir), segmentFiles(meetingDir)...) {
+		if info, err := os.Stat(path); err == nil && time.Since(info.ModTime()) < activeRecordingWindow {
+			return true
+		}
+	}
+	return false
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
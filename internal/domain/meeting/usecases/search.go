@@ -0,0 +1,372 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/devbydaniel/meetingcli/internal/domain/meeting"
+)
+
+const (
+	// indexChunkInterval starts a new chunk inside long speaker turns, so
+	// each chunk covers about a minute of talk at most.
+	indexChunkInterval = time.Minute
+	embedBatchSize     = 64
+	// rrfK damps the weight of top ranks when fusing BM25 and vector
+	// rankings (reciprocal rank fusion).
+	rrfK         = 60
+	snippetWidth = 160
+)
+
+// Embedder turns texts into vectors for semantic search.
+type Embedder interface {
+	Embed(texts []string) ([][]float32, error)
+	// Model identifies the embedding model; vectors of different models
+	// aren't comparable.
+	Model() string
+}
+
+// SearchHit is a transcript passage or summary section matching a query.
+type SearchHit struct {
+	Meeting   string // folder name
+	Source    string // meeting.ChunkSummary, or empty for the transcript
+	Speaker   string
+	Start     float64
+	Timestamp string // hh:mm:ss of Start; empty for summary sections
+	Text      string
+	Snippet   string // part of Text around the first query term
+	Score     float64
+}
+
+// SearchOptions narrow down a query.
+type SearchOptions struct {
+	Limit   int       // maximum number of hits; 0 for all
+	Since   time.Time // only meetings started at or after Since, if set
+	Meeting string    // only the meeting in this folder, if set
+}
+
+// Search finds transcript passages and summary sections across all
+// meetings with BM25 full-text search, fused with vector search when an
+// Embedder is configured. The index is kept in meeting.IndexDir and
+// updated incrementally.
+type Search struct {
+	Meetings *meeting.Repository
+	Embedder Embedder // nil searches full text only
+}
+
+// Update brings the index up to date: meetings whose transcript, summary
+// or speaker names changed since they were indexed are (re)indexed, and
+// deleted meetings are dropped. Returns the number of meetings indexed.
+func (s *Search) Update() (int, error) {
+	_, _, n, err := s.update()
+	return n, err
+}
+
+// Query updates the index and returns the passages matching query, best
+// first.
+func (s *Search) Query(query string, opts SearchOptions) ([]SearchHit, error) {
+	idx, meetings, _, err := s.update()
+	if err != nil {
+		return nil, err
+	}
+	started := make(map[string]time.Time, len(meetings))
+	for _, m := range meetings {
+		started[filepath.Base(m.Dir)] = startTime(m)
+	}
+
+	// Flatten the index in a stable order.
+	var hits []SearchHit
+	var docs [][]string
+	var vectors [][]float32
+	for _, name := range slices.Sorted(maps.Keys(idx.Meetings)) {
+		if opts.Meeting != "" && name != opts.Meeting {
+			continue
+		}
+		// Like List, meetings whose start time is unknown are excluded.
+		if t := started[name]; !opts.Since.IsZero() && (t.IsZero() || t.Before(opts.Since)) {
+			continue
+		}
+		for _, c := range idx.Meetings[name].Chunks {
+			hit := SearchHit{
+				Meeting: name,
+				Source:  c.Source,
+				Speaker: c.Speaker,
+				Start:   c.Start,
+				Text:    c.Text,
+			}
+			if c.Source != meeting.ChunkSummary {
+				hit.Timestamp = clockTime(c.Start)
+			}
+			hits = append(hits, hit)
+			docs = append(docs, tokenize(c.Speaker+" "+c.Text))
+			vectors = append(vectors, c.Embedding)
+		}
+	}
+
+	terms := tokenize(query)
+	scores := bm25Scores(docs, terms)
+	if s.Embedder != nil {
+		// Without a query vector, full-text search still finds passages.
+		embedded, err := s.Embedder.Embed([]string{query})
+		if err == nil && len(embedded) != 1 {
+			err = errors.New("no vector returned")
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "warning: could not embed query, using full-text search only: %v\n", err)
+		} else {
+			similarities := make([]float64, len(vectors))
+			for i, v := range vectors {
+				similarities[i] = cosineSimilarity(embedded[0], v)
+			}
+			scores = fuseRanks(scores, similarities)
+		}
+	}
+
+	var ranked []SearchHit
+	for i, hit := range hits {
+		if scores[i] > 0 {
+			hit.Score = scores[i]
+			hit.Snippet = snippet(hit.Text, terms, snippetWidth)
+			ranked = append(ranked, hit)
+		}
+	}
+	slices.SortStableFunc(ranked, func(a, b SearchHit) int {
+		switch {
+		case a.Score > b.Score:
+			return -1
+		case a.Score < b.Score:
+			return 1
+		}
+		return 0
+	})
+	if opts.Limit > 0 && len(ranked) > opts.Limit {
+		ranked = ranked[:opts.Limit]
+	}
+	return ranked, nil
+}
+
+// update brings the index up to date and returns it with the meetings and
+// the number of meetings indexed. A meeting that fails to index is skipped
+// with a warning, keeping its previous entry if it has one.
+func (s *Search) update() (*meeting.Index, []*meeting.Meeting, int, error) {
+	meetingsDir := s.Meetings.MeetingsDir
+	idx, err := meeting.ReadIndex(meetingsDir)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	meetings, err := s.Meetings.List()
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	model := ""
+	if s.Embedder != nil {
+		model = s.Embedder.Model()
+	}
+
+	changed := false
+	indexed := 0
+	present := make(map[string]bool)
+	for _, m := range meetings {
+		// Meetings transcribed before transcript.json existed only have
+		// transcript.md, which ReadTranscript falls back to.
+		info, err := os.Stat(filepath.Join(m.Dir, meeting.TranscriptDataFile))
+		if errors.Is(err, os.ErrNotExist) {
+			info, err = os.Stat(filepath.Join(m.Dir, meeting.TranscriptFile))
+		}
+		if err != nil {
+			continue // not transcribed (yet)
+		}
+		name := filepath.Base(m.Dir)
+		present[name] = true
+		var summaryModified time.Time
+		if info, err := os.Stat(filepath.Join(m.Dir, meeting.SummaryFile)); err == nil {
+			summaryModified = info.ModTime()
+		}
+
+		entry := idx.Meetings[name]
+		if entry != nil && entry.Modified.Equal(info.ModTime()) && entry.SummaryModified.Equal(summaryModified) &&
+			maps.Equal(entry.Speakers, m.Speakers) && (model == "" || entry.EmbeddingModel == model) {
+			continue
+		}
+		entry, err = s.indexMeeting(m, info.ModTime(), summaryModified)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "warning: could not index %s: %v\n", name, err)
+			continue
+		}
+		idx.Meetings[name] = entry
+		indexed++
+		changed = true
+	}
+	for name := range idx.Meetings {
+		if !present[name] {
+			delete(idx.Meetings, name)
+			changed = true
+		}
+	}
+
+	if changed {
+		if err := meeting.WriteIndex(meetingsDir, idx); err != nil {
+			return nil, nil, 0, err
+		}
+	}
+	return idx, meetings, indexed, nil
+}
+
+// indexMeeting splits the transcript and summary of m into chunks,
+// embedding them if an Embedder is configured.
+func (s *Search) indexMeeting(m *meeting.Meeting, modified, summaryModified time.Time) (*meeting.IndexEntry, error) {
+	t, err := meeting.ReadTranscript(m.Dir)
+	if err != nil {
+		return nil, err
+	}
+	entry := &meeting.IndexEntry{
+		Modified:        modified,
+		SummaryModified: summaryModified,
+		Speakers:        m.Speakers,
+		Chunks:          transcriptChunks(t.WithSpeakerNames(m.Speakers)),
+	}
+	if !summaryModified.IsZero() {
+		summary, err := os.ReadFile(filepath.Join(m.Dir, meeting.SummaryFile))
+		if err != nil {
+			return nil, err
+		}
+		entry.Chunks = append(entry.Chunks, summaryChunks(string(summary))...)
+	}
+
+	if s.Embedder == nil || len(entry.Chunks) == 0 {
+		return entry, nil
+	}
+	texts := make([]string, len(entry.Chunks))
+	for i, c := range entry.Chunks {
+		texts[i] = c.Text
+		if c.Speaker != "" {
+			texts[i] = c.Speaker + ": " + c.Text
+		}
+	}
+	for start := 0; start < len(texts); start += embedBatchSize {
+		end := min(start+embedBatchSize, len(texts))
+		vectors, err := s.Embedder.Embed(texts[start:end])
+		if err != nil {
+			return nil, fmt.Errorf("embedding: %w", err)
+		}
+		if len(vectors) != end-start {
+			return nil, fmt.Errorf("embedding: got %d vectors for %d texts", len(vectors), end-start)
+		}
+		for i, v := range vectors {
+			entry.Chunks[start+i].Embedding = v
+		}
+	}
+	entry.EmbeddingModel = s.Embedder.Model()
+	return entry, nil
+}
+
+// transcriptChunks splits t into passages of one speaker, starting a new
+// one every indexChunkInterval inside long turns.
+func transcriptChunks(t *meeting.Transcript) []meeting.IndexChunk {
+	var chunks []meeting.IndexChunk
+	for _, turn := range transcriptTurns(t, indexChunkInterval) {
+		for _, p := range turn.Paragraphs {
+			chunks = append(chunks, meeting.IndexChunk{Speaker: turn.Speaker, Start: p.Start, Text: p.Text})
+		}
+	}
+	if len(chunks) > 0 {
+		return chunks
+	}
+	// Transcripts without segments have no speakers or timestamps.
+	for _, line := range transcriptLines(t) {
+		chunks = append(chunks, meeting.IndexChunk{Text: line})
+	}
+	return chunks
+}
+
+// summaryChunks splits a summary into its sections, leaving out the title.
+func summaryChunks(summary string) []meeting.IndexChunk {
+	var chunks []meeting.IndexChunk
+	var section []string
+	flush := func() {
+		if text := strings.TrimSpace(strings.Join(section, "\n")); text != "" {
+			chunks = append(chunks, meeting.IndexChunk{Source: meeting.ChunkSummary, Text: text})
+		}
+		section = nil
+	}
+	for _, line := range strings.Split(summary, "\n") {
+		if strings.HasPrefix(line, "# ") {
+			continue
+		}
+		if strings.HasPrefix(line, "## ") {
+			flush()
+		}
+		section = append(section, line)
+	}
+	flush()
+	return chunks
+}
+
+// fuseRanks combines two sets of scores for the same documents by
+// reciprocal rank fusion. Documents scoring zero in both stay at zero.
+func fuseRanks(a, b []float64) []float64 {
+	fused := make([]float64, len(a))
+	for _, scores := range [][]float64{a, b} {
+		order := make([]int, 0, len(scores))
+		for i, score := range scores {
+			if score > 0 {
+				order = append(order, i)
+			}
+		}
+		slices.SortStableFunc(order, func(i, j int) int {
+			switch {
+			case scores[i] > scores[j]:
+				return -1
+			case scores[i] < scores[j]:
+				return 1
+			}
+			return 0
+		})
+		for rank, i := range order {
+			fused[i] += 1.0 / float64(rrfK+rank+1)
+		}
+	}
+	return fused
+}
+
+// snippet returns about width characters of text around the first of
+// terms it contains, or its start, on a single line.
+func snippet(text string, terms []string, width int) string {
+	text = strings.Join(strings.Fields(text), " ")
+	runes := []rune(text)
+	if len(runes) <= width {
+		return text
+	}
+
+	pos := 0
+	lower := []rune(strings.ToLower(text))
+	if len(lower) == len(runes) {
+		first := -1
+		for _, term := range terms {
+			if i := strings.Index(string(lower), term); i >= 0 {
+				if r := len([]rune(string(lower)[:i])); first < 0 || r < first {
+					first = r
+				}
+			}
+		}
+		pos = max(first, 0)
+	}
+
+	start := max(pos-width/3, 0)
+	end := min(start+width, len(runes))
+	start = max(end-width, 0)
+	result := strings.TrimSpace(string(runes[start:end]))
+	if start > 0 {
+		result = "…" + result
+	}
+	if end < len(runes) {
+		result += "…"
+	}
+	return result
+}
@@ -0,0 +1,251 @@
+package usecases
+
+import (
+	"errors"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/devbydaniel/meetingcli/internal/domain/meeting"
+)
+
+// fakeEmbedder maps each text to a vector of its length, failing for texts
+// containing fail.
+type fakeEmbedder struct {
+	fail string
+}
+
+func (f *fakeEmbedder) Embed(texts []string) ([][]float32, error) {
+	vectors := make([][]float32, len(texts))
+	for i, text := range texts {
+		if f.fail != "" && strings.Contains(text, f.fail) {
+			return nil, errors.New("embedding service down")
+		}
+		vectors[i] = []float32{float32(len(text)), 1}
+	}
+	return vectors, nil
+}
+
+func (f *fakeEmbedder) Model() string { return "fake" }
+
+// writeMeetings creates a transcribed meeting per folder name, each saying text.
+func writeMeetings(t *testing.T, texts map[string]string) string {
+	t.Helper()
+	meetingsDir := t.TempDir()
+	for name, text := range texts {
+		dir := filepath.Join(meetingsDir, name)
+		if err := os.Mkdir(dir, 0o755); err != nil {
+			t.Fatal(err)
+		}
+		transcript := &meeting.Transcript{Segments: []meeting.TranscriptSegment{seg("speaker_0", text, 0, 5)}}
+		if err := meeting.WriteTranscript(dir, transcript); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return meetingsDir
+}
+
+func TestSearchSkipsMeetingsThatFailToIndex(t *testing.T) {
+	meetingsDir := writeMeetings(t, map[string]string{
+		"a": "We agreed on the roadmap.",
+		"b": "The roadmap boom is broken.",
+		"c": "Roadmap review next week.",
+	})
+	repo := meeting.NewRepository(meetingsDir)
+	s := &Search{Meetings: repo, Embedder: &fakeEmbedder{fail: "boom"}}
+
+	n, err := s.Update()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 2 {
+		t.Errorf("indexed %d meetings, want 2", n)
+	}
+	idx, err := meeting.ReadIndex(meetingsDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"a", "c"} {
+		if idx.Meetings[name] == nil {
+			t.Errorf("meeting %s is not indexed", name)
+		}
+	}
+	if idx.Meetings["b"] != nil {
+		t.Error("meeting b is indexed despite failing")
+	}
+
+	// b is retried on the next update.
+	s.Embedder = &fakeEmbedder{}
+	if n, err := s.Update(); err != nil || n != 1 {
+		t.Errorf("second update indexed %d meetings (%v), want 1", n, err)
+	}
+}
+
+func TestSearchIndexesMarkdownOnlyTranscripts(t *testing.T) {
+	meetingsDir := writeMeetings(t, map[string]string{"new": "Lunch options."})
+	old := filepath.Join(meetingsDir, "old")
+	if err := os.Mkdir(old, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	md := filepath.Join(old, meeting.TranscriptFile)
+	if err := os.WriteFile(md, []byte("# Meeting Transcript\n\nWe moved the launch to May.\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	lastWeek := time.Now().Add(-7 * 24 * time.Hour)
+	if err := os.Chtimes(md, lastWeek, lastWeek); err != nil {
+		t.Fatal(err)
+	}
+	s := &Search{Meetings: meeting.NewRepository(meetingsDir)}
+
+	hits, err := s.Query("launch", SearchOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hits) != 1 || hits[0].Meeting != "old" || hits[0].Text != "We moved the launch to May." {
+		t.Fatalf("got %+v, want the passage from transcript.md", hits)
+	}
+
+	// A transcript.json written later replaces the markdown entry.
+	err = meeting.WriteTranscript(old, &meeting.Transcript{Segments: []meeting.TranscriptSegment{seg("speaker_0", "The launch is in June.", 0, 5)}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, err := s.Update(); err != nil || n != 1 {
+		t.Fatalf("update indexed %d meetings (%v), want 1", n, err)
+	}
+	hits, err = s.Query("launch", SearchOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hits) != 1 || hits[0].Text != "The launch is in June." {
+		t.Errorf("got %+v, want the passage from transcript.json", hits)
+	}
+}
+
+func TestSearchSince(t *testing.T) {
+	meetingsDir := writeMeetings(t, map[string]string{
+		"2026-03-02_09-00-00_old":   "Roadmap review.",
+		"2026-03-10_09-00-00_new":   "Roadmap review.",
+		"2026-03-01_09-00-00_moved": "Roadmap review.",
+		"notes":                     "Roadmap review.",
+	})
+	repo := meeting.NewRepository(meetingsDir)
+	// meeting.json takes precedence over the date in the folder name.
+	err := repo.Update(filepath.Join(meetingsDir, "2026-03-01_09-00-00_moved"), func(m *meeting.Meeting) {
+		m.StartedAt = time.Date(2026, 3, 12, 9, 0, 0, 0, time.Local)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &Search{Meetings: repo}
+
+	hits, err := s.Query("roadmap", SearchOptions{Since: time.Date(2026, 3, 5, 0, 0, 0, 0, time.Local)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, h := range hits {
+		got = append(got, h.Meeting)
+	}
+	slices.Sort(got)
+	if want := []string{"2026-03-01_09-00-00_moved", "2026-03-10_09-00-00_new"}; !slices.Equal(got, want) {
+		t.Errorf("meetings = %v, want %v", got, want)
+	}
+}
+
+func TestSearchFallsBackToFullTextWhenQueryEmbeddingFails(t *testing.T) {
+	meetingsDir := writeMeetings(t, map[string]string{
+		"a": "We agreed on the roadmap.",
+		"b": "Lunch options.",
+	})
+	repo := meeting.NewRepository(meetingsDir)
+	s := &Search{Meetings: repo, Embedder: &fakeEmbedder{fail: "roadmap?"}}
+
+	hits, err := s.Query("roadmap?", SearchOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hits) != 1 || hits[0].Meeting != "a" {
+		t.Errorf("got %+v, want the roadmap passage", hits)
+	}
+}
+
+func TestFuseRanks(t *testing.T) {
+	rrf := func(rank int) float64 { return 1.0 / float64(rrfK+rank) }
+	tests := []struct {
+		name string
+		a, b []float64
+		want []float64
+	}{
+		{
+			name: "ranks are summed",
+			a:    []float64{3, 0, 1},
+			b:    []float64{0, 0.5, 0.9},
+			want: []float64{rrf(1), rrf(2), rrf(2) + rrf(1)},
+		},
+		{
+			name: "zero in both stays zero",
+			a:    []float64{0, 1},
+			b:    []float64{0, 0},
+			want: []float64{0, rrf(1)},
+		},
+		{
+			name: "negative similarity is no match",
+			a:    []float64{0, 0},
+			b:    []float64{-0.2, 0.4},
+			want: []float64{0, rrf(1)},
+		},
+		{
+			name: "ties keep document order",
+			a:    []float64{1, 1},
+			b:    []float64{0, 0},
+			want: []float64{rrf(1), rrf(2)},
+		},
+		{name: "empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := fuseRanks(tt.a, tt.b)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if math.Abs(got[i]-tt.want[i]) > 1e-12 {
+					t.Errorf("got %v, want %v", got, tt.want)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestSnippet(t *testing.T) {
+	long := strings.Repeat("a ", 50) + "target " + strings.Repeat("b ", 50)
+	tests := []struct {
+		name  string
+		text  string
+		terms []string
+		width int
+		want  string
+	}{
+		{name: "short text on one line", text: "hello\n  world", terms: []string{"world"}, width: 30, want: "hello world"},
+		{name: "around the term", text: long, terms: []string{"target"}, width: 30, want: "…a a a a a target b b b b b b b…"},
+		{name: "earliest of the terms", text: long, terms: []string{"b", "target"}, width: 30, want: "…a a a a a target b b b b b b b…"},
+		{name: "term not found", text: long, terms: []string{"missing"}, width: 10, want: "a a a a a…"},
+		{name: "term at the end", text: strings.Repeat("a ", 50) + "end", terms: []string{"end"}, width: 10, want: "…a a a end"},
+		{name: "case insensitive", text: long + "Zed", terms: []string{"zed"}, width: 9, want: "…b b b Zed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := snippet(tt.text, tt.terms, tt.width); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package usecases
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/devbydaniel/meetingcli/internal/domain/meeting"
+)
+
+// speakerSamples is how many utterances Speakers.List returns per speaker.
+const speakerSamples = 3
+
+// SpeakerInfo describes one diarized speaker of a meeting.
+type SpeakerInfo struct {
+	ID      string // diarization label, e.g. speaker_0
+	Name    string // assigned name, empty if none
+	Talk    time.Duration
+	Samples []string // a few of the speaker's longest utterances, in order
+}
+
+// Speakers lists the diarized speakers of a meeting and assigns names to
+// them. Names are stored in meeting.json; transcript.json keeps the labels.
+type Speakers struct {
+	Meetings   *meeting.Repository
+	Transcribe *Transcribe // renders transcript.md
+	Export     *Export     // rewrites existing subtitle files
+}
+
+// List returns the speakers of the meeting in dir in order of appearance.
+func (s *Speakers) List(dir string) ([]SpeakerInfo, error) {
+	transcript, err := readSegments(dir)
+	if err != nil {
+		return nil, err
+	}
+	m, err := s.Meetings.Load(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var speakers []SpeakerInfo
+	utterances := make(map[string][]meeting.TranscriptSegment)
+	for _, seg := range transcript.Segments {
+		if seg.Speaker == "" {
+			continue
+		}
+		if _, ok := utterances[seg.Speaker]; !ok {
+			speakers = append(speakers, SpeakerInfo{ID: seg.Speaker, Name: m.Speakers[seg.Speaker]})
+		}
+		utterances[seg.Speaker] = append(utterances[seg.Speaker], seg)
+	}
+	if len(speakers) == 0 {
+		return nil, fmt.Errorf("transcript has no speaker labels: the transcription provider doesn't diarize")
+	}
+
+	for i := range speakers {
+		segs := utterances[speakers[i].ID]
+		for _, seg := range segs {
+			speakers[i].Talk += time.Duration((seg.End - seg.Start) * float64(time.Second))
+		}
+		speakers[i].Samples = samples(segs)
+	}
+	return speakers, nil
+}
+
+// Rename stores names (label → name; an empty name removes it) for the
+// meeting in dir and re-renders transcript.md and any subtitle files.
+// Labels that don't occur in the transcript are rejected.
+func (s *Speakers) Rename(dir string, names map[string]string) error {
+	transcript, err := readSegments(dir)
+	if err != nil {
+		return err
+	}
+
+	var ids []string
+	for _, seg := range transcript.Segments {
+		if seg.Speaker != "" && !slices.Contains(ids, seg.Speaker) {
+			ids = append(ids, seg.Speaker)
+		}
+	}
+	var unknown []string
+	for id := range names {
+		if !slices.Contains(ids, id) {
+			unknown = append(unknown, id)
+		}
+	}
+	if len(unknown) > 0 {
+		slices.Sort(unknown)
+		if len(ids) == 0 {
+			return fmt.Errorf("unknown speaker %s: the transcript has no speaker labels", strings.Join(unknown, ", "))
+		}
+		return fmt.Errorf("unknown speaker %s (expected %s)", strings.Join(unknown, ", "), strings.Join(ids, ", "))
+	}
+
+	var assigned map[string]string
+	err = s.Meetings.Update(dir, func(m *meeting.Meeting) {
+		if m.Speakers == nil {
+			m.Speakers = make(map[string]string)
+		}
+		for id, name := range names {
+			if name = strings.TrimSpace(name); name == "" {
+				delete(m.Speakers, id)
+			} else {
+				m.Speakers[id] = name
+			}
+		}
+		assigned = m.Speakers
+	})
+	if err != nil {
+		return err
+	}
+
+	named := transcript.WithSpeakerNames(assigned)
+	if err := s.Transcribe.writeMarkdown(named, dir); err != nil {
+		return err
+	}
+	for format, file := range map[string]string{SubtitleSRT: meeting.SRTFile, SubtitleVTT: meeting.VTTFile} {
+		if !fileExists(filepath.Join(dir, file)) {
+			continue
+		}
+		if _, err := s.Export.write(named, dir, format); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readSegments loads transcript.json, which must have segments.
+func readSegments(dir string) (*meeting.Transcript, error) {
+	transcript, err := meeting.ReadTranscript(dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("no %s in %s: run `meeting transcribe` first", meeting.TranscriptDataFile, dir)
+		}
+		return nil, err
+	}
+	if len(transcript.Segments) == 0 {
+		return nil, fmt.Errorf("transcript has no segments: re-run `meeting transcribe --force`")
+	}
+	return transcript, nil
+}
+
+// samples picks the longest utterances from segs, returned in spoken order.
+func samples(segs []meeting.TranscriptSegment) []string {
+	picked := slices.Clone(segs)
+	slices.SortStableFunc(picked, func(a, b meeting.TranscriptSegment) int {
+		return len(b.Text) - len(a.Text)
+	})
+	picked = picked[:min(speakerSamples, len(picked))]
+	slices.SortFunc(picked, func(a, b meeting.TranscriptSegment) int {
+		return cmp.Compare(a.Start, b.Start)
+	})
+
+	texts := make([]string, len(picked))
+	for i, seg := range picked {
+		texts[i] = strings.TrimSpace(seg.Text)
+	}
+	return texts
+}
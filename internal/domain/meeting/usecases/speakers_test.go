@@ -0,0 +1,93 @@
+package usecases
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/devbydaniel/meetingcli/internal/domain/meeting"
+)
+
+func TestSpeakersRename(t *testing.T) {
+	tests := []struct {
+		name    string
+		names   map[string]string
+		want    map[string]string
+		wantErr string
+	}{
+		{
+			name:  "assign",
+			names: map[string]string{"speaker_0": "Ana", "speaker_1": " Ben "},
+			want:  map[string]string{"speaker_0": "Ana", "speaker_1": "Ben"},
+		},
+		{
+			name:  "remove",
+			names: map[string]string{"speaker_1": ""},
+			want:  map[string]string{"speaker_0": "Old"},
+		},
+		{
+			name:    "unknown label",
+			names:   map[string]string{"speaker_0": "Ana", "speaker_9": "Bob"},
+			want:    map[string]string{"speaker_0": "Old", "speaker_1": "Older"},
+			wantErr: "unknown speaker speaker_9 (expected speaker_1, speaker_0)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			repo := meeting.NewRepository(filepath.Dir(dir))
+			if err := repo.Save(&meeting.Meeting{Dir: dir, Speakers: map[string]string{"speaker_0": "Old", "speaker_1": "Older"}}); err != nil {
+				t.Fatal(err)
+			}
+			err := meeting.WriteTranscript(dir, &meeting.Transcript{Segments: []meeting.TranscriptSegment{
+				seg("speaker_1", "Hi.", 0, 1),
+				seg("speaker_0", "Hello.", 1, 2),
+			}})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			speakers := &Speakers{Meetings: repo, Transcribe: &Transcribe{Template: "{{range .Turns}}{{.Speaker}}\n{{end}}"}}
+			err = speakers.Rename(dir, tt.names)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("Rename error = %v, want %q", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Fatalf("Rename: %v", err)
+			}
+
+			m, err := repo.Load(dir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(m.Speakers) != len(tt.want) {
+				t.Fatalf("speakers = %v, want %v", m.Speakers, tt.want)
+			}
+			for id, name := range tt.want {
+				if m.Speakers[id] != name {
+					t.Errorf("speakers = %v, want %v", m.Speakers, tt.want)
+				}
+			}
+			if tt.wantErr == "" {
+				if _, err := os.Stat(filepath.Join(dir, meeting.TranscriptFile)); err != nil {
+					t.Errorf("transcript.md not rendered: %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestSpeakersRenameUndiarized(t *testing.T) {
+	dir := t.TempDir()
+	repo := meeting.NewRepository(filepath.Dir(dir))
+	if err := meeting.WriteTranscript(dir, &meeting.Transcript{Segments: []meeting.TranscriptSegment{seg("", "Hi.", 0, 1)}}); err != nil {
+		t.Fatal(err)
+	}
+	err := (&Speakers{Meetings: repo}).Rename(dir, map[string]string{"speaker_0": "Ana"})
+	if err == nil || !strings.Contains(err.Error(), "no speaker labels") {
+		t.Errorf("Rename error = %v, want no speaker labels", err)
+	}
+}
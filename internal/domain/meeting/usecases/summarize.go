@@ -1,109 +1,216 @@
 package usecases
 
 import (
-	"bytes"
-	"encoding/json"
+	"cmp"
 	"fmt"
-	"io"
-	"net/http"
 	"os"
 	"path/filepath"
+	"slices"
+	"sync"
+
+	"github.com/devbydaniel/meetingcli/internal/domain/meeting"
 )
 
-// Summarize generates a meeting summary using Claude Haiku 4.5.
-type Summarize struct {
-	APIKey       string
-	SystemPrompt string
+// Summarizer sends a prompt to a language model and returns its reply.
+type Summarizer interface {
+	Summarize(systemPrompt, prompt string) (string, error)
+	// Model identifies the model used, recorded in meeting.json.
+	Model() string
 }
 
-// Execute generates a summary from the transcript and writes summary.md.
-func (s *Summarize) Execute(transcript string, meetingDir string) (string, error) {
-	if s.APIKey == "" {
-		return "", fmt.Errorf("anthropic API key not set: set MEETINGCLI_ANTHROPIC_API_KEY or add anthropic_api_key to config")
-	}
+// SummaryOutput is an additional artifact generated from the transcript
+// alongside summary.md, such as a TL;DR or a follow-up email draft.
+type SummaryOutput struct {
+	Name   string
+	Prompt string // system prompt
+	File   string // file name in the meeting directory
+}
 
-	reqBody := anthropicRequest{
-		Model:     "claude-haiku-4-5",
-		MaxTokens: 4096,
-		System:    s.SystemPrompt,
-		Messages: []anthropicMessage{
-			{
-				Role:    "user",
-				Content: "Here is the meeting transcript to summarize:\n\n" + transcript,
-			},
-		},
-	}
+// reservedFiles can't be overwritten by a SummaryOutput.
+var reservedFiles = []string{
+	meeting.AudioFile, meeting.MicFile, meeting.SystemFile,
+	meeting.TranscriptFile, meeting.TranscriptDataFile, meeting.SummaryFile,
+	meeting.MetadataFile, meeting.ActionsFile, meeting.SRTFile, meeting.VTTFile,
+}
+
+// Summarize generates a meeting summary via the configured Summarizer.
+type Summarize struct {
+	Summarizer Summarizer
+	// Templates maps template names to system prompts. Meetings without a
+	// template in meeting.json use DefaultTemplate.
+	Templates       map[string]string
+	DefaultTemplate string
+	Meetings        *meeting.Repository
+	// Transcripts estimated at more than TokenBudget tokens are summarized
+	// in parts that are then combined. Zero sends every transcript at once.
+	TokenBudget int
+	// Outputs are generated next to summary.md, with up to Concurrency
+	// requests (summary.md included) at once.
+	Outputs     []SummaryOutput
+	Concurrency int
+}
 
-	jsonBody, err := json.Marshal(reqBody)
+// Execute generates summary.md and the configured outputs from the
+// transcript, and returns the paths written, summary.md first if it was
+// written. Without force, files that already exist are kept and only the
+// missing ones are generated. Only a failure of summary.md fails the stage;
+// outputs that fail are reported as warnings and left out of the paths.
+func (s *Summarize) Execute(transcript *meeting.Transcript, meetingDir string, force bool) ([]string, error) {
+	var paths []string
+	var template string
+	err := trackStage(s.Meetings, meetingDir, meeting.StageSummarize, func() error {
+		var err error
+		paths, template, err = s.summarize(transcript, meetingDir, force)
+		return err
+	})
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", "https://api.anthropic.com/v1/messages", bytes.NewReader(jsonBody))
-	if err != nil {
-		return "", err
+	updateMeeting(s.Meetings, meetingDir, func(m *meeting.Meeting) {
+		m.SummaryModel = s.Summarizer.Model()
+		m.Template = template
+	})
+	return paths, nil
+}
+
+// Missing reports whether summary.md or any configured output is missing
+// from meetingDir.
+func (s *Summarize) Missing(meetingDir string) bool {
+	if !fileExists(filepath.Join(meetingDir, meeting.SummaryFile)) {
+		return true
+	}
+	for _, o := range s.Outputs {
+		if !fileExists(filepath.Join(meetingDir, o.File)) {
+			return true
+		}
 	}
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("x-api-key", s.APIKey)
-	req.Header.Set("anthropic-version", "2023-06-01")
+	return false
+}
 
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return "", fmt.Errorf("calling Anthropic API: %w", err)
+// Template returns the system prompt of the named template, or of the
+// default template for "".
+func (s *Summarize) Template(name string) (string, error) {
+	if name == "" {
+		name = s.DefaultTemplate
 	}
-	defer resp.Body.Close()
+	prompt, ok := s.Templates[name]
+	if !ok {
+		return "", fmt.Errorf("unknown template %q: see `meeting templates list`", name)
+	}
+	return prompt, nil
+}
 
-	respBody, err := io.ReadAll(resp.Body)
+// summaryJob is one file generated from the transcript.
+type summaryJob struct {
+	name         string
+	systemPrompt string
+	path         string
+	header       string
+}
+
+// summarize writes summary.md using the meeting's template, and one file
+// per output, skipping existing files unless force. Returns the paths
+// written and the name of the template.
+func (s *Summarize) summarize(transcript *meeting.Transcript, meetingDir string, force bool) ([]string, string, error) {
+	m := loadMeeting(s.Meetings, meetingDir)
+	template := cmp.Or(m.Template, s.DefaultTemplate)
+	systemPrompt, err := s.Template(template)
 	if err != nil {
-		return "", fmt.Errorf("reading response: %w", err)
+		return nil, "", err
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("anthropic API error (HTTP %d): %s", resp.StatusCode, string(respBody))
+	if err := s.validateOutputs(); err != nil {
+		return nil, "", err
 	}
-
-	var apiResp anthropicResponse
-	if err := json.Unmarshal(respBody, &apiResp); err != nil {
-		return "", fmt.Errorf("parsing Anthropic response: %w", err)
+	all := []summaryJob{{
+		name:         "summary",
+		systemPrompt: systemPrompt,
+		path:         filepath.Join(meetingDir, meeting.SummaryFile),
+		header:       "# Meeting Summary\n\n",
+	}}
+	for _, o := range s.Outputs {
+		all = append(all, summaryJob{
+			name:         o.Name,
+			systemPrompt: o.Prompt,
+			path:         filepath.Join(meetingDir, o.File),
+		})
 	}
-
-	// Extract text from response
-	var summary string
-	for _, block := range apiResp.Content {
-		if block.Type == "text" {
-			summary += block.Text
+	var jobs []summaryJob
+	for _, job := range all {
+		if force || !fileExists(job.path) {
+			jobs = append(jobs, job)
 		}
 	}
-
-	if summary == "" {
-		return "", fmt.Errorf("empty response from Anthropic API")
+	if len(jobs) == 0 {
+		return nil, template, nil
 	}
 
-	// Write summary.md
-	summaryContent := "# Meeting Summary\n\n" + summary + "\n"
-	summaryPath := filepath.Join(meetingDir, "summary.md")
-	if err := os.WriteFile(summaryPath, []byte(summaryContent), 0o644); err != nil {
-		return "", fmt.Errorf("writing summary: %w", err)
+	// Long transcripts are condensed once and the notes shared by all jobs.
+	named := transcript.WithSpeakerNames(m.Speakers)
+	prompt := "Here is the meeting transcript to summarize:\n\n" + attendeeHint(m) + transcriptText(named)
+	if s.TokenBudget > 0 && estimateTokens(transcriptText(named)) > s.TokenBudget {
+		prompt, err = s.partsPrompt(attendeeHint(m), transcriptLines(named))
+		if err != nil {
+			return nil, "", err
+		}
 	}
 
-	return summary, nil
-}
-
-type anthropicRequest struct {
-	Model     string             `json:"model"`
-	MaxTokens int                `json:"max_tokens"`
-	System    string             `json:"system"`
-	Messages  []anthropicMessage `json:"messages"`
+	errs := make([]error, len(jobs))
+	sem := make(chan struct{}, max(s.Concurrency, 1))
+	var wg sync.WaitGroup
+	for i, job := range jobs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			errs[i] = s.generate(job, prompt)
+		}()
+	}
+	wg.Wait()
+
+	// Files that were written are kept even if other jobs failed. The
+	// stage only fails with summary.md; a missing output is generated again
+	// on the next resume.
+	var paths []string
+	for i, job := range jobs {
+		switch {
+		case errs[i] == nil:
+			paths = append(paths, job.path)
+		case job.path == all[0].path:
+			return nil, "", errs[i]
+		default:
+			fmt.Fprintf(os.Stderr, "warning: %v\n", errs[i])
+		}
+	}
+	return paths, template, nil
 }
 
-type anthropicMessage struct {
-	Role    string `json:"role"`
-	Content string `json:"content"`
+// validateOutputs checks that every output writes its own file next to
+// summary.md, without overwriting a meeting artifact.
+func (s *Summarize) validateOutputs() error {
+	owners := make(map[string]string)
+	for _, o := range s.Outputs {
+		if o.File == "" || filepath.Base(o.File) != o.File || slices.Contains(reservedFiles, o.File) {
+			return fmt.Errorf("output %q: invalid file name %q", o.Name, o.File)
+		}
+		if other, ok := owners[o.File]; ok {
+			return fmt.Errorf("outputs %q and %q both write %s: set a different filename for one of them", other, o.Name, o.File)
+		}
+		owners[o.File] = o.Name
+	}
+	return nil
 }
 
-type anthropicResponse struct {
-	Content []struct {
-		Type string `json:"type"`
-		Text string `json:"text"`
-	} `json:"content"`
+// generate sends prompt with the job's system prompt and writes the reply.
+func (s *Summarize) generate(job summaryJob, prompt string) error {
+	reply, err := s.Summarizer.Summarize(job.systemPrompt, prompt)
+	if err != nil {
+		return fmt.Errorf("generating %s: %w", job.name, err)
+	}
+	if err := os.WriteFile(job.path, []byte(job.header+reply+"\n"), 0o644); err != nil {
+		return fmt.Errorf("writing %s: %w", job.name, err)
+	}
+	return nil
 }
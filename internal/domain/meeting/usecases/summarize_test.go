@@ -0,0 +1,152 @@
+package usecases
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/devbydaniel/meetingcli/internal/domain/meeting"
+)
+
+// fakeSummarizer replies with the system prompt, or fails for prompts in fail.
+type fakeSummarizer struct {
+	fail []string
+
+	mu    sync.Mutex
+	calls []string // system prompts, in no particular order
+}
+
+func (f *fakeSummarizer) Summarize(systemPrompt, prompt string) (string, error) {
+	f.mu.Lock()
+	f.calls = append(f.calls, systemPrompt)
+	f.mu.Unlock()
+	if slices.Contains(f.fail, systemPrompt) {
+		return "", errors.New("model unavailable")
+	}
+	return systemPrompt, nil
+}
+
+func (f *fakeSummarizer) Model() string { return "fake" }
+
+func TestSummarizeExecute(t *testing.T) {
+	outputs := []SummaryOutput{
+		{Name: "email", Prompt: "email prompt", File: "email.md"},
+		{Name: "tldr", Prompt: "tldr prompt", File: "tldr.md"},
+	}
+	tests := []struct {
+		name     string
+		outputs  []SummaryOutput
+		existing []string // files in the meeting folder beforehand
+		force    bool
+		fail     []string
+		want     []string // files written
+		wantErr  bool
+	}{
+		{
+			name:    "everything",
+			outputs: outputs,
+			want:    []string{meeting.SummaryFile, "email.md", "tldr.md"},
+		},
+		{
+			name:    "failed output is left out",
+			outputs: outputs,
+			fail:    []string{"tldr prompt"},
+			want:    []string{meeting.SummaryFile, "email.md"},
+		},
+		{
+			name:    "failed summary fails the stage",
+			outputs: outputs,
+			fail:    []string{"summary prompt"},
+			wantErr: true,
+		},
+		{
+			name:     "only missing outputs",
+			outputs:  outputs,
+			existing: []string{meeting.SummaryFile, "email.md"},
+			want:     []string{"tldr.md"},
+		},
+		{
+			name:     "force regenerates all",
+			outputs:  outputs,
+			existing: []string{meeting.SummaryFile, "email.md"},
+			force:    true,
+			want:     []string{meeting.SummaryFile, "email.md", "tldr.md"},
+		},
+		{
+			name:     "nothing missing",
+			outputs:  outputs,
+			existing: []string{meeting.SummaryFile, "email.md", "tldr.md"},
+		},
+		{
+			name: "duplicate file names",
+			outputs: []SummaryOutput{
+				{Name: "email", Prompt: "email prompt", File: "notes.md"},
+				{Name: "tldr", Prompt: "tldr prompt", File: "notes.md"},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "reserved file name",
+			outputs: []SummaryOutput{{Name: "evil", Prompt: "evil prompt", File: meeting.ActionsFile}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for _, file := range tt.existing {
+				if err := os.WriteFile(filepath.Join(dir, file), []byte("old"), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			summarizer := &fakeSummarizer{fail: tt.fail}
+			s := &Summarize{
+				Summarizer:      summarizer,
+				Templates:       map[string]string{"default": "summary prompt"},
+				DefaultTemplate: "default",
+				Meetings:        meeting.NewRepository(filepath.Dir(dir)),
+				Outputs:         tt.outputs,
+				Concurrency:     2,
+			}
+
+			paths, err := s.Execute(&meeting.Transcript{Text: "Hello."}, dir, tt.force)
+			m, loadErr := s.Meetings.Load(dir)
+			if loadErr != nil {
+				t.Fatal(loadErr)
+			}
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, wrote %v", paths)
+				}
+				if got := m.StageStatus(meeting.StageSummarize); got != meeting.StatusFailed {
+					t.Errorf("summarize stage = %q, want %q", got, meeting.StatusFailed)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			for _, path := range paths {
+				got = append(got, filepath.Base(path))
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("wrote %v, want %v", got, tt.want)
+			}
+			if len(summarizer.calls) != len(tt.want)+len(tt.fail) {
+				t.Errorf("made %d requests, want %d", len(summarizer.calls), len(tt.want)+len(tt.fail))
+			}
+			if got := m.StageStatus(meeting.StageSummarize); got != meeting.StatusDone {
+				t.Errorf("summarize stage = %q, want %q", got, meeting.StatusDone)
+			}
+			if s.Missing(dir) != (len(tt.fail) > 0) {
+				t.Errorf("Missing = %v after summarizing", s.Missing(dir))
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package usecases
+
+import (
+	"cmp"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/devbydaniel/meetingcli/internal/domain/meeting"
+)
+
+// Speaker labels for separately transcribed tracks. Everything on the mic
+// track is the local user; the system track is everyone else, split further
+// by diarization when the provider supports it.
+const (
+	MeSpeaker   = "Me"
+	ThemSpeaker = "Them"
+)
+
+// hasTracks reports whether meetingDir still has both source tracks.
+func hasTracks(meetingDir string) bool {
+	return fileExists(filepath.Join(meetingDir, meeting.MicFile)) &&
+		fileExists(filepath.Join(meetingDir, meeting.SystemFile))
+}
+
+// transcribeTracks transcribes mic.wav and system.wav separately and
+// interleaves their segments by start time. Both tracks are transcribed at
+// the same time, sharing the slots of sem.
+func (t *Transcribe) transcribeTracks(meetingDir string, sem chan struct{}) (*meeting.Transcript, error) {
+	files := []string{meeting.MicFile, meeting.SystemFile}
+	results := make([]*meeting.Transcript, len(files))
+	errs := make([]error, len(files))
+	var wg sync.WaitGroup
+	for i, file := range files {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i], errs[i] = t.transcribeChunked(filepath.Join(meetingDir, file), sem)
+		}()
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			return nil, fmt.Errorf("transcribing %s: %w", files[i], err)
+		}
+	}
+	return interleaveTracks(results[0], results[1]), nil
+}
+
+// interleaveTracks merges the mic and system transcripts into one, ordered
+// by segment start. If a track has text but no segments (the provider gave
+// no timestamps), the tracks can't be interleaved; the result then has no
+// segments and its text is each track's text after its speaker label.
+func interleaveTracks(mic, system *meeting.Transcript) *meeting.Transcript {
+	if untimed(mic) || untimed(system) {
+		var parts []string
+		for _, track := range []struct {
+			speaker    string
+			transcript *meeting.Transcript
+		}{{MeSpeaker, mic}, {ThemSpeaker, system}} {
+			if text := trackText(track.transcript); text != "" {
+				parts = append(parts, track.speaker+": "+text)
+			}
+		}
+		return &meeting.Transcript{Text: strings.Join(parts, "\n\n")}
+	}
+
+	var segments []meeting.TranscriptSegment
+	for _, seg := range mic.Segments {
+		seg.Speaker = MeSpeaker
+		segments = append(segments, seg)
+	}
+	for _, seg := range system.Segments {
+		if seg.Speaker == "" {
+			seg.Speaker = ThemSpeaker
+		}
+		segments = append(segments, seg)
+	}
+	slices.SortStableFunc(segments, func(a, b meeting.TranscriptSegment) int {
+		return cmp.Compare(a.Start, b.Start)
+	})
+
+	return &meeting.Transcript{Text: segmentText(segments), Segments: segments}
+}
+
+// untimed reports whether t has text but no segments to place it in time.
+func untimed(t *meeting.Transcript) bool {
+	return len(t.Segments) == 0 && strings.TrimSpace(t.Text) != ""
+}
+
+// trackText returns the text of a track transcript, from its segments when
+// it has any.
+func trackText(t *meeting.Transcript) string {
+	if len(t.Segments) > 0 {
+		return segmentText(t.Segments)
+	}
+	return strings.TrimSpace(t.Text)
+}
+
+// segmentText joins the non-empty texts of segments.
+func segmentText(segments []meeting.TranscriptSegment) string {
+	texts := make([]string, 0, len(segments))
+	for _, seg := range segments {
+		if text := strings.TrimSpace(seg.Text); text != "" {
+			texts = append(texts, text)
+		}
+	}
+	return strings.Join(texts, " ")
+}
@@ -0,0 +1,149 @@
+package usecases
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/devbydaniel/meetingcli/internal/domain/meeting"
+)
+
+func TestInterleaveTracks(t *testing.T) {
+	tests := []struct {
+		name        string
+		mic, system *meeting.Transcript
+		want        *meeting.Transcript
+	}{
+		{
+			name: "segments are ordered by start",
+			mic: &meeting.Transcript{Segments: []meeting.TranscriptSegment{
+				seg("speaker_0", "Hi all.", 0, 2),
+				seg("speaker_0", "Sounds good.", 6, 7),
+			}},
+			system: &meeting.Transcript{Segments: []meeting.TranscriptSegment{
+				seg("speaker_1", "Hello!", 2, 3),
+				seg("", "Shall we start?", 4, 5),
+			}},
+			want: &meeting.Transcript{
+				Text: "Hi all. Hello! Shall we start? Sounds good.",
+				Segments: []meeting.TranscriptSegment{
+					seg(MeSpeaker, "Hi all.", 0, 2),
+					seg("speaker_1", "Hello!", 2, 3),
+					seg(ThemSpeaker, "Shall we start?", 4, 5),
+					seg(MeSpeaker, "Sounds good.", 6, 7),
+				},
+			},
+		},
+		{
+			name:   "a track without segments falls back to text",
+			mic:    &meeting.Transcript{Text: " Hi all. Sounds good. "},
+			system: &meeting.Transcript{Segments: []meeting.TranscriptSegment{seg("speaker_1", "Hello!", 2, 3)}},
+			want:   &meeting.Transcript{Text: "Me: Hi all. Sounds good.\n\nThem: Hello!"},
+		},
+		{
+			name:   "silent track is left out",
+			mic:    &meeting.Transcript{Text: "Anyone there?"},
+			system: &meeting.Transcript{},
+			want:   &meeting.Transcript{Text: "Me: Anyone there?"},
+		},
+		{
+			name:   "both silent",
+			mic:    &meeting.Transcript{},
+			system: &meeting.Transcript{},
+			want:   &meeting.Transcript{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := interleaveTracks(tt.mic, tt.system)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v\nwant %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// blockingTranscriber returns one segment per file once release is closed,
+// signalling each start on started and counting how many requests are in
+// flight at once.
+type blockingTranscriber struct {
+	started  chan string
+	release  chan struct{}
+	mu       sync.Mutex
+	inFlight int
+	peak     int
+}
+
+func (b *blockingTranscriber) Transcribe(audioPath string) (*meeting.Transcript, error) {
+	b.mu.Lock()
+	b.inFlight++
+	b.peak = max(b.peak, b.inFlight)
+	b.mu.Unlock()
+
+	b.started <- audioPath
+	<-b.release
+
+	b.mu.Lock()
+	b.inFlight--
+	b.mu.Unlock()
+	return &meeting.Transcript{Segments: []meeting.TranscriptSegment{seg("", filepath.Base(audioPath), 0, 1)}}, nil
+}
+
+func (b *blockingTranscriber) Model() string { return "test" }
+
+func TestTranscribeTracksConcurrency(t *testing.T) {
+	tests := []struct {
+		concurrency int
+		want        int
+	}{
+		{concurrency: 0, want: 1},
+		{concurrency: 1, want: 1},
+		{concurrency: 2, want: 2},
+		{concurrency: 4, want: 2},
+	}
+
+	for _, tt := range tests {
+		dir := t.TempDir()
+		for _, file := range []string{meeting.MicFile, meeting.SystemFile} {
+			if err := os.WriteFile(filepath.Join(dir, file), nil, 0o644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		transcriber := &blockingTranscriber{started: make(chan string, 2), release: make(chan struct{})}
+		tr := &Transcribe{Transcriber: transcriber, Concurrency: tt.concurrency}
+
+		done := make(chan *meeting.Transcript)
+		go func() {
+			result, err := tr.transcribeTracks(dir, make(chan struct{}, max(tt.concurrency, 1)))
+			if err != nil {
+				t.Error(err)
+			}
+			done <- result
+		}()
+		for range tt.want {
+			select {
+			case <-transcriber.started:
+			case <-time.After(5 * time.Second):
+				t.Fatalf("concurrency %d: tracks didn't start", tt.concurrency)
+			}
+		}
+		select {
+		case path := <-transcriber.started:
+			t.Errorf("concurrency %d: %s started beyond the limit", tt.concurrency, filepath.Base(path))
+		default:
+		}
+		close(transcriber.release)
+		result := <-done
+
+		if transcriber.peak != tt.want {
+			t.Errorf("concurrency %d: %d tracks in flight, want %d", tt.concurrency, transcriber.peak, tt.want)
+		}
+		if result == nil || len(result.Segments) != 2 {
+			t.Errorf("concurrency %d: got %+v, want both tracks", tt.concurrency, result)
+		}
+	}
+}
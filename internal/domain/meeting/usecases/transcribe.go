@@ -1,162 +1,115 @@
 package usecases
 
 import (
-	"bytes"
-	"encoding/json"
 	"fmt"
-	"io"
-	"mime/multipart"
-	"net/http"
 	"os"
 	"path/filepath"
-	"strings"
+	"time"
+
+	"github.com/devbydaniel/meetingcli/internal/domain/meeting"
 )
 
-// Transcribe handles audio transcription via Mistral Voxtral API.
-type Transcribe struct {
-	APIKey string
+// Transcriber turns a single audio file into a transcript.
+type Transcriber interface {
+	// Transcribe transcribes audioPath. Segment times are relative to its start.
+	Transcribe(audioPath string) (*meeting.Transcript, error)
+	// Model identifies the model used, recorded in meeting.json.
+	Model() string
 }
 
-// TranscriptSegment represents a diarized segment of the transcript.
-type TranscriptSegment struct {
-	Speaker string `json:"speaker"`
-	Text    string `json:"text"`
+// Splitter cuts a recording into overlapping chunks for transcription.
+type Splitter interface {
+	Split(audioPath, outDir string, chunk, overlap time.Duration) ([]meeting.AudioChunk, error)
 }
 
-// TranscriptResult holds the full transcription result.
-type TranscriptResult struct {
-	Text     string              `json:"text"`
-	Segments []TranscriptSegment `json:"segments"`
+// Transcribe handles audio transcription via the configured Transcriber.
+type Transcribe struct {
+	Transcriber Transcriber
+	Meetings    *meeting.Repository
+	// Recordings longer than ChunkDuration are split (at silence where
+	// possible) into chunks overlapping by ChunkOverlap, and up to
+	// Concurrency chunks (of either track, see SeparateTracks) are
+	// transcribed at once. A nil Splitter or zero ChunkDuration always sends
+	// the whole file.
+	Splitter      Splitter
+	ChunkDuration time.Duration
+	ChunkOverlap  time.Duration
+	Concurrency   int
+	// SeparateTracks transcribes mic.wav and system.wav on their own when
+	// both exist, labelling mic speech MeSpeaker and leaving diarization to
+	// split the remote side, instead of transcribing the mixed recording.
+	SeparateTracks bool
+	// Template renders transcript.md (see TranscriptTemplateData), with a
+	// timestamped paragraph inside long turns every MarkerInterval.
+	Template       string
+	MarkerInterval time.Duration
+	// Subtitles writes a subtitle file for each of SubtitleFormats after
+	// transcribing. Failures are warnings; the transcript is kept.
+	Subtitles       *Export
+	SubtitleFormats []string
 }
 
-// Execute transcribes the audio file and writes transcript.md to the meeting directory.
-func (t *Transcribe) Execute(audioPath string, meetingDir string) (*TranscriptResult, error) {
-	if t.APIKey == "" {
-		return nil, fmt.Errorf("mistral API key not set: set MEETINGCLI_MISTRAL_API_KEY or add mistral_api_key to config")
-	}
-
-	// Build multipart request
-	body := &bytes.Buffer{}
-	writer := multipart.NewWriter(body)
-
-	// Add model field
-	if err := writer.WriteField("model", "voxtral-mini-latest"); err != nil {
-		return nil, err
-	}
-
-	// Add diarize field
-	if err := writer.WriteField("diarize", "true"); err != nil {
+// Execute transcribes the audio file and writes transcript.json and
+// transcript.md to the meeting directory.
+func (t *Transcribe) Execute(audioPath string, meetingDir string) (*meeting.Transcript, error) {
+	var result *meeting.Transcript
+	err := trackStage(t.Meetings, meetingDir, meeting.StageTranscribe, func() error {
+		var err error
+		result, err = t.transcribe(audioPath, meetingDir)
+		return err
+	})
+	if err != nil {
 		return nil, err
 	}
 
-	// Required when diarize is true
-	if err := writer.WriteField("timestamp_granularities[]", "segment"); err != nil {
-		return nil, err
-	}
+	updateMeeting(t.Meetings, meetingDir, func(m *meeting.Meeting) {
+		m.TranscriptionModel = t.Transcriber.Model()
+		// Diarization labels are per transcription, so names assigned to
+		// the previous transcript no longer apply.
+		m.Speakers = nil
+	})
+	return result, nil
+}
 
-	// Add audio file
-	file, err := os.Open(audioPath)
-	if err != nil {
-		return nil, fmt.Errorf("opening audio file: %w", err)
+func (t *Transcribe) transcribe(audioPath string, meetingDir string) (*meeting.Transcript, error) {
+	var result *meeting.Transcript
+	var err error
+	sem := make(chan struct{}, max(t.Concurrency, 1))
+	if t.SeparateTracks && hasTracks(meetingDir) {
+		result, err = t.transcribeTracks(meetingDir, sem)
+	} else {
+		result, err = t.transcribeChunked(audioPath, sem)
 	}
-	defer file.Close()
-
-	part, err := writer.CreateFormFile("file", filepath.Base(audioPath))
 	if err != nil {
 		return nil, err
 	}
-	if _, err := io.Copy(part, file); err != nil {
-		return nil, err
-	}
 
-	if err := writer.Close(); err != nil {
+	if err := meeting.WriteTranscript(meetingDir, result); err != nil {
 		return nil, err
 	}
 
-	// Make request
-	req, err := http.NewRequest("POST", "https://api.mistral.ai/v1/audio/transcriptions", body)
-	if err != nil {
+	if err := t.writeMarkdown(result, meetingDir); err != nil {
 		return nil, err
 	}
-	req.Header.Set("Content-Type", writer.FormDataContentType())
-	req.Header.Set("Authorization", "Bearer "+t.APIKey)
-
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("calling Mistral API: %w", err)
-	}
-	defer resp.Body.Close()
 
-	respBody, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("reading response: %w", err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("mistral API error (HTTP %d): %s", resp.StatusCode, string(respBody))
-	}
-
-	// Parse response
-	var apiResp transcriptionAPIResponse
-	if err := json.Unmarshal(respBody, &apiResp); err != nil {
-		return nil, fmt.Errorf("parsing Mistral response: %w", err)
-	}
-
-	result := &TranscriptResult{
-		Text: apiResp.Text,
-	}
-
-	// Extract diarized segments if available
-	for _, seg := range apiResp.Segments {
-		result.Segments = append(result.Segments, TranscriptSegment{
-			Speaker: seg.SpeakerID,
-			Text:    seg.Text,
-		})
-	}
-
-	// Write transcript.md
-	transcriptPath := filepath.Join(meetingDir, "transcript.md")
-	content := formatTranscript(result)
-	if err := os.WriteFile(transcriptPath, []byte(content), 0o644); err != nil {
-		return nil, fmt.Errorf("writing transcript: %w", err)
+	for _, format := range t.SubtitleFormats {
+		if _, err := t.Subtitles.write(result, meetingDir, format); err != nil {
+			fmt.Fprintf(os.Stderr, "warning: could not write %s subtitles: %v\n", format, err)
+		}
 	}
 
 	return result, nil
 }
 
-func formatTranscript(result *TranscriptResult) string {
-	var sb strings.Builder
-	sb.WriteString("# Meeting Transcript\n\n")
-
-	if len(result.Segments) > 0 {
-		currentSpeaker := ""
-		for _, seg := range result.Segments {
-			if seg.Speaker != currentSpeaker {
-				currentSpeaker = seg.Speaker
-				speaker := currentSpeaker
-				if speaker == "" {
-					speaker = "Unknown"
-				}
-				sb.WriteString(fmt.Sprintf("\n**%s:**\n", speaker))
-			}
-			sb.WriteString(seg.Text + " ")
-		}
-	} else {
-		// No diarization — just dump the full text
-		sb.WriteString(result.Text)
+// writeMarkdown renders transcript.md from the transcript template.
+func (t *Transcribe) writeMarkdown(result *meeting.Transcript, meetingDir string) error {
+	content, err := renderTranscript(t.Template, t.MarkerInterval, result)
+	if err != nil {
+		return err
 	}
-
-	sb.WriteString("\n")
-	return sb.String()
-}
-
-// transcriptionAPIResponse matches the Mistral transcription API response.
-type transcriptionAPIResponse struct {
-	Text     string `json:"text"`
-	Segments []struct {
-		SpeakerID string  `json:"speaker_id"`
-		Text      string  `json:"text"`
-		Start     float64 `json:"start"`
-		End       float64 `json:"end"`
-	} `json:"segments"`
+	transcriptPath := filepath.Join(meetingDir, meeting.TranscriptFile)
+	if err := os.WriteFile(transcriptPath, []byte(content), 0o644); err != nil {
+		return fmt.Errorf("writing transcript: %w", err)
+	}
+	return nil
 }
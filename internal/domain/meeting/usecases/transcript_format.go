@@ -0,0 +1,160 @@
+package usecases
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/devbydaniel/meetingcli/internal/domain/meeting"
+)
+
+// TranscriptTemplateData is passed to the transcript template.
+type TranscriptTemplateData struct {
+	Text  string // full text, the only content of transcripts without segments
+	Turns []TranscriptTurn
+}
+
+// TranscriptTurn is a run of consecutive segments by the same speaker.
+type TranscriptTurn struct {
+	Speaker    string // empty when the provider doesn't diarize
+	Timestamp  string // hh:mm:ss of the start of the turn
+	Start      float64
+	Paragraphs []TranscriptParagraph
+}
+
+// TranscriptParagraph is part of a turn. A turn is split into paragraphs
+// every marker interval, so long turns carry timestamps inside them.
+type TranscriptParagraph struct {
+	Timestamp string
+	Start     float64
+	Text      string
+}
+
+// renderTranscript renders t through the transcript template, starting a new
+// paragraph inside a turn every interval (zero: never).
+func renderTranscript(transcriptTemplate string, interval time.Duration, t *meeting.Transcript) (string, error) {
+	tmpl, err := template.New("transcript").Parse(transcriptTemplate)
+	if err != nil {
+		return "", fmt.Errorf("invalid transcript template: %w", err)
+	}
+
+	data := TranscriptTemplateData{
+		Text:  t.Text,
+		Turns: transcriptTurns(t, interval),
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("executing transcript template: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// transcriptTurns groups the segments of t into speaker turns.
+func transcriptTurns(t *meeting.Transcript, interval time.Duration) []TranscriptTurn {
+	diarized := hasSpeakers(t)
+	var turns []TranscriptTurn
+	for _, seg := range t.Segments {
+		text := strings.TrimSpace(seg.Text)
+		if text == "" {
+			continue
+		}
+		speaker := seg.Speaker
+		if diarized && speaker == "" {
+			speaker = "Unknown"
+		}
+
+		if len(turns) == 0 || turns[len(turns)-1].Speaker != speaker {
+			turns = append(turns, TranscriptTurn{
+				Speaker:   speaker,
+				Timestamp: clockTime(seg.Start),
+				Start:     seg.Start,
+			})
+		}
+		turn := &turns[len(turns)-1]
+
+		n := len(turn.Paragraphs)
+		if n == 0 || (interval > 0 && seg.Start-turn.Paragraphs[n-1].Start >= interval.Seconds()) {
+			turn.Paragraphs = append(turn.Paragraphs, TranscriptParagraph{
+				Timestamp: clockTime(seg.Start),
+				Start:     seg.Start,
+				Text:      text,
+			})
+			continue
+		}
+		turn.Paragraphs[n-1].Text += " " + text
+	}
+	return turns
+}
+
+// transcriptText renders t as plain text for language model prompts: one
+// "[hh:mm:ss] speaker: text" line per turn, independent of the user's
+// transcript template.
+func transcriptText(t *meeting.Transcript) string {
+	return strings.Join(transcriptLines(t), "\n") + "\n"
+}
+
+// transcriptLines returns the lines of transcriptText. Transcripts without
+// segments are split into their lines of text.
+func transcriptLines(t *meeting.Transcript) []string {
+	turns := transcriptTurns(t, 0)
+	if len(turns) == 0 {
+		var lines []string
+		for _, line := range strings.Split(t.Text, "\n") {
+			if line = strings.TrimSpace(line); line != "" {
+				lines = append(lines, line)
+			}
+		}
+		return lines
+	}
+
+	lines := make([]string, len(turns))
+	for i, turn := range turns {
+		var sb strings.Builder
+		sb.WriteString("[" + turn.Timestamp + "] ")
+		if turn.Speaker != "" {
+			sb.WriteString(turn.Speaker + ": ")
+		}
+		for j, p := range turn.Paragraphs {
+			if j > 0 {
+				sb.WriteString(" ")
+			}
+			sb.WriteString(p.Text)
+		}
+		lines[i] = sb.String()
+	}
+	return lines
+}
+
+// promptTranscript renders t for a language model prompt with the speaker
+// names from m applied, preceded by the attendee list when there is one.
+func promptTranscript(m *meeting.Meeting, t *meeting.Transcript) string {
+	return attendeeHint(m) + transcriptText(t.WithSpeakerNames(m.Speakers))
+}
+
+// attendeeHint introduces the attendees of m to the model, or is empty.
+func attendeeHint(m *meeting.Meeting) string {
+	if len(m.Attendees) == 0 {
+		return ""
+	}
+	return "Attendees: " + strings.Join(m.Attendees, ", ") +
+		". Speaker labels in the transcript may belong to any of them; use their names for owners where it is clear who is speaking.\n\n"
+}
+
+// hasSpeakers reports whether any segment carries a speaker label.
+func hasSpeakers(result *meeting.Transcript) bool {
+	for _, seg := range result.Segments {
+		if seg.Speaker != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// clockTime formats seconds from the start of the recording as hh:mm:ss.
+func clockTime(seconds float64) string {
+	s := int64(max(seconds, 0))
+	return fmt.Sprintf("%02d:%02d:%02d", s/3600, s/60%60, s%60)
+}
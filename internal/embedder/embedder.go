@@ -0,0 +1,3 @@
+// Package embedder implements the usecases.Embedder interface on top of
+// OpenAI-compatible and Ollama embedding APIs.
+package embedder
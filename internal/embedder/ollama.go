@@ -0,0 +1,57 @@
+package embedder
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/devbydaniel/meetingcli/internal/httpjson"
+)
+
+const (
+	DefaultOllamaBaseURL = "http://localhost:11434"
+	DefaultOllamaModel   = "nomic-embed-text"
+)
+
+// Ollama embeds texts with a local model via Ollama's /api/embed.
+type Ollama struct {
+	BaseURL string
+	model   string
+}
+
+func NewOllama(baseURL, model string) *Ollama {
+	if baseURL == "" {
+		baseURL = DefaultOllamaBaseURL
+	}
+	if model == "" {
+		model = DefaultOllamaModel
+	}
+	return &Ollama{BaseURL: strings.TrimRight(baseURL, "/"), model: model}
+}
+
+func (o *Ollama) Model() string {
+	return o.model
+}
+
+func (o *Ollama) Embed(texts []string) ([][]float32, error) {
+	reqBody := embeddingsRequest{Model: o.model, Input: texts}
+	resp, respBody, err := httpjson.Post(o.BaseURL+"/api/embed", nil, reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("calling Ollama at %s: %w", o.BaseURL, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("ollama error (HTTP %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	var apiResp ollamaEmbedResponse
+	if err := json.Unmarshal(respBody, &apiResp); err != nil {
+		return nil, fmt.Errorf("parsing Ollama response: %w", err)
+	}
+	return apiResp.Embeddings, nil
+}
+
+type ollamaEmbedResponse struct {
+	Embeddings [][]float32 `json:"embeddings"`
+}
@@ -0,0 +1,86 @@
+package embedder
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/devbydaniel/meetingcli/internal/httpjson"
+)
+
+const (
+	DefaultOpenAIBaseURL = "https://api.openai.com/v1"
+	DefaultOpenAIModel   = "text-embedding-3-small"
+)
+
+// OpenAI embeds texts via an OpenAI-compatible /v1/embeddings endpoint:
+// OpenAI, or local servers such as llama.cpp, vLLM and LM Studio.
+type OpenAI struct {
+	APIKey  string // optional for self-hosted servers
+	BaseURL string
+	model   string
+}
+
+func NewOpenAI(apiKey, baseURL, model string) *OpenAI {
+	if baseURL == "" {
+		baseURL = DefaultOpenAIBaseURL
+	}
+	if model == "" {
+		model = DefaultOpenAIModel
+	}
+	return &OpenAI{APIKey: apiKey, BaseURL: strings.TrimRight(baseURL, "/"), model: model}
+}
+
+func (o *OpenAI) Model() string {
+	return o.model
+}
+
+func (o *OpenAI) Embed(texts []string) ([][]float32, error) {
+	headers := map[string]string{}
+	if o.APIKey != "" {
+		headers["Authorization"] = "Bearer " + o.APIKey
+	}
+
+	reqBody := embeddingsRequest{Model: o.model, Input: texts}
+	resp, respBody, err := httpjson.Post(o.BaseURL+"/embeddings", headers, reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("calling embeddings API at %s: %w", o.BaseURL, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("embeddings API error (HTTP %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	var apiResp openAIEmbeddingsResponse
+	if err := json.Unmarshal(respBody, &apiResp); err != nil {
+		return nil, fmt.Errorf("parsing embeddings response: %w", err)
+	}
+
+	// Results carry their input index and aren't guaranteed to be in order.
+	vectors := make([][]float32, len(texts))
+	for _, d := range apiResp.Data {
+		if d.Index < 0 || d.Index >= len(vectors) {
+			return nil, fmt.Errorf("embeddings response has unexpected index %d", d.Index)
+		}
+		vectors[d.Index] = d.Embedding
+	}
+	for i, v := range vectors {
+		if v == nil {
+			return nil, fmt.Errorf("embeddings response is missing input %d", i)
+		}
+	}
+	return vectors, nil
+}
+
+type embeddingsRequest struct {
+	Model string   `json:"model"`
+	Input []string `json:"input"`
+}
+
+type openAIEmbeddingsResponse struct {
+	Data []struct {
+		Index     int       `json:"index"`
+		Embedding []float32 `json:"embedding"`
+	} `json:"data"`
+}
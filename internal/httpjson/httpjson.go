@@ -0,0 +1,82 @@
+// Package httpjson sends JSON requests to the HTTP APIs of the model
+// providers.
+package httpjson
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// Post sends body as JSON and returns the response with its body read.
+func Post(url string, headers map[string]string, body any) (*http.Response, []byte, error) {
+	req, err := newRequest(url, headers, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return resp, nil, err
+	}
+	return resp, respBody, nil
+}
+
+// PostStream sends body as JSON and calls onLine for each non-empty line of
+// a successful streaming response (server-sent events or NDJSON). Other
+// responses are returned as an error with their status and body.
+func PostStream(url string, headers map[string]string, body any, onLine func(line string) error) error {
+	req, err := newRequest(url, headers, body)
+	if err != nil {
+		return err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("HTTP %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" {
+			if err := onLine(line); err != nil {
+				return err
+			}
+		}
+	}
+	return scanner.Err()
+}
+
+func newRequest(url string, headers map[string]string, body any) (*http.Request, error) {
+	jsonBody, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", url, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	return req, nil
+}
@@ -3,6 +3,8 @@ package output
 import (
 	"fmt"
 	"io"
+	"strings"
+	"text/tabwriter"
 	"time"
 )
 
@@ -18,6 +20,14 @@ func (f *Formatter) RecordingStopped(duration time.Duration) {
 	fmt.Fprintf(f.w, "⏹️  Recording stopped (%s)\n", formatDuration(duration))
 }
 
+func (f *Formatter) Importing(path string) {
+	fmt.Fprintf(f.w, "📥 Importing %s...\n", path)
+}
+
+func (f *Formatter) Recovering(name string) {
+	fmt.Fprintf(f.w, "🩹 Recovering %s...\n", name)
+}
+
 func (f *Formatter) Transcribing() {
 	fmt.Fprintf(f.w, "📝 Transcribing audio...\n")
 }
@@ -34,6 +44,26 @@ func (f *Formatter) SummarizeDone(path string) {
 	fmt.Fprintf(f.w, "✅ Summary saved: %s\n", path)
 }
 
+func (f *Formatter) OutputDone(path string) {
+	fmt.Fprintf(f.w, "✅ Saved: %s\n", path)
+}
+
+func (f *Formatter) ExtractingActions() {
+	fmt.Fprintf(f.w, "📋 Extracting action items...\n")
+}
+
+func (f *Formatter) ActionsDone(path string, count int) {
+	fmt.Fprintf(f.w, "✅ %d action items saved: %s\n", count, path)
+}
+
+func (f *Formatter) ExportDone(path string) {
+	fmt.Fprintf(f.w, "✅ Subtitles saved: %s\n", path)
+}
+
+func (f *Formatter) Skipped(path string) {
+	fmt.Fprintf(f.w, "⏭️  Already exists, skipping (use --force to regenerate): %s\n", path)
+}
+
 func (f *Formatter) MeetingComplete(dir string) {
 	fmt.Fprintf(f.w, "\n📁 Meeting saved: %s\n", dir)
 }
@@ -54,18 +84,153 @@ func (f *Formatter) Warning(msg string) {
 	fmt.Fprintf(f.w, "⚠️  %s\n", msg)
 }
 
-func (f *Formatter) MeetingListHeader() {
-	fmt.Fprintf(f.w, "📁 Meetings:\n\n")
+// MeetingRow is a line of the meeting list.
+type MeetingRow struct {
+	Folder    string
+	StartedAt time.Time // zero if unknown
+	Duration  time.Duration
+	Tags      []string
+	Status    string // complete, failed, missing-transcript, ...
 }
 
-func (f *Formatter) MeetingListItem(name string, hasTranscript, hasSummary bool) {
-	status := ""
-	if hasTranscript && hasSummary {
-		status = " ✅"
-	} else if hasTranscript {
-		status = " 📝"
+func (f *Formatter) MeetingTable(rows []MeetingRow) {
+	tw := tabwriter.NewWriter(f.w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "DATE\tDURATION\tMEETING\tTAGS\tSTATUS")
+	for _, r := range rows {
+		date, duration := "-", "-"
+		if !r.StartedAt.IsZero() {
+			date = r.StartedAt.Local().Format("2006-01-02 15:04")
+		}
+		if r.Duration > 0 {
+			duration = formatDuration(r.Duration)
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s %s\n", date, duration, r.Folder, strings.Join(r.Tags, ","), statusMark(r.Status), r.Status)
+	}
+	tw.Flush()
+}
+
+func statusMark(status string) string {
+	switch status {
+	case "complete":
+		return "✅"
+	case "failed":
+		return "❌"
+	case "missing-summary":
+		return "📝"
+	}
+	return "⏳"
+}
+
+// MeetingDetails is what `meeting show` prints about a meeting.
+type MeetingDetails struct {
+	Folder             string
+	Dir                string
+	Name               string
+	StartedAt          time.Time // zero if unknown
+	Duration           time.Duration
+	Tags               []string
+	Attendees          []string
+	Speakers           []SpeakerTalk
+	TranscriptionModel string
+	SummaryModel       string
+	Template           string
+	Status             string
+}
+
+// SpeakerTalk is a speaker and how long they talked.
+type SpeakerTalk struct {
+	Label string
+	Talk  time.Duration
+}
+
+func (f *Formatter) MeetingDetails(d MeetingDetails) {
+	fmt.Fprintf(f.w, "📁 %s\n", d.Folder)
+	tw := tabwriter.NewWriter(f.w, 0, 0, 1, ' ', 0)
+	field := func(label, value string) {
+		if value != "" {
+			fmt.Fprintf(tw, "   %s:\t%s\n", label, value)
+		}
+	}
+	field("Name", d.Name)
+	field("Path", d.Dir)
+	if !d.StartedAt.IsZero() {
+		field("Date", d.StartedAt.Local().Format("2006-01-02 15:04"))
+	}
+	if d.Duration > 0 {
+		field("Duration", formatDuration(d.Duration))
+	}
+	field("Status", statusMark(d.Status)+" "+d.Status)
+	field("Tags", strings.Join(d.Tags, ", "))
+	field("Attendees", strings.Join(d.Attendees, ", "))
+	speakers := make([]string, len(d.Speakers))
+	for i, s := range d.Speakers {
+		speakers[i] = fmt.Sprintf("%s (%s)", s.Label, formatDuration(s.Talk))
 	}
-	fmt.Fprintf(f.w, "  %s%s\n", name, status)
+	field("Speakers", strings.Join(speakers, ", "))
+	field("Transcription model", d.TranscriptionModel)
+	field("Summary model", d.SummaryModel)
+	field("Template", d.Template)
+	tw.Flush()
+}
+
+func (f *Formatter) SearchHit(meeting, timestamp, speaker, snippet string) {
+	location := meeting + " @" + timestamp
+	if speaker != "" {
+		location += " — " + speaker
+	}
+	fmt.Fprintf(f.w, "🔎 %s\n   %s\n", location, snippet)
+}
+
+func (f *Formatter) SourceListHeader() {
+	fmt.Fprintf(f.w, "\n📚 Sources:\n")
+}
+
+func (f *Formatter) SourceListItem(meeting string, locations []string) {
+	fmt.Fprintf(f.w, "  %s @ %s\n", meeting, strings.Join(locations, ", "))
+}
+
+func (f *Formatter) ActionListHeader(meeting string) {
+	fmt.Fprintf(f.w, "\n📋 %s\n", meeting)
+}
+
+func (f *Formatter) ActionListItem(n int, task, owner, due, timestamp string, done bool) {
+	box := "[ ]"
+	if done {
+		box = "[x]"
+	}
+	line := fmt.Sprintf("  %s %d. %s", box, n, task)
+	if owner != "" {
+		line += " — " + owner
+	}
+	if due != "" {
+		line += " (due " + due + ")"
+	}
+	if timestamp != "" {
+		line += " @" + timestamp
+	}
+	fmt.Fprintln(f.w, line)
+}
+
+func (f *Formatter) SpeakerListItem(id, name string, talk time.Duration, samples []string) {
+	label := id
+	if name != "" {
+		label += " → " + name
+	}
+	fmt.Fprintf(f.w, "🗣️  %s (%s)\n", label, formatDuration(talk))
+	for _, sample := range samples {
+		fmt.Fprintf(f.w, "     %q\n", truncate(sample, 100))
+	}
+}
+
+func (f *Formatter) TemplateListItem(name, description, source string) {
+	line := "  " + name
+	if description != "" {
+		line += " — " + description
+	}
+	if source != "" {
+		line += " (" + source + ")"
+	}
+	fmt.Fprintln(f.w, line)
 }
 
 func (f *Formatter) SetupCheck(name string, ok bool, detail string) {
@@ -92,3 +257,12 @@ func formatDuration(d time.Duration) string {
 	}
 	return fmt.Sprintf("%ds", s)
 }
+
+// truncate shortens s to at most n runes, marking the cut with an ellipsis.
+func truncate(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n-1]) + "…"
+}
@@ -0,0 +1,168 @@
+package summarizer
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/devbydaniel/meetingcli/internal/domain/meeting"
+	"github.com/devbydaniel/meetingcli/internal/httpjson"
+)
+
+const (
+	DefaultAnthropicBaseURL = "https://api.anthropic.com/v1"
+	DefaultAnthropicModel   = "claude-haiku-4-5"
+)
+
+// Anthropic summarizes via the Anthropic Messages API.
+type Anthropic struct {
+	APIKey  string
+	BaseURL string
+	opts    Options
+}
+
+func NewAnthropic(apiKey, baseURL string, opts Options) *Anthropic {
+	if baseURL == "" {
+		baseURL = DefaultAnthropicBaseURL
+	}
+	if opts.Model == "" {
+		opts.Model = DefaultAnthropicModel
+	}
+	return &Anthropic{APIKey: apiKey, BaseURL: strings.TrimRight(baseURL, "/"), opts: opts}
+}
+
+func (a *Anthropic) Model() string {
+	return a.opts.Model
+}
+
+func (a *Anthropic) Summarize(systemPrompt, prompt string) (string, error) {
+	if a.APIKey == "" {
+		return "", fmt.Errorf("anthropic API key not set: set MEETINGCLI_ANTHROPIC_API_KEY or add anthropic_api_key to config")
+	}
+
+	reqBody := anthropicRequest{
+		Model:       a.opts.Model,
+		MaxTokens:   a.opts.maxTokens(),
+		Temperature: a.opts.Temperature,
+		System:      systemPrompt,
+		Messages: []chatMessage{
+			{Role: "user", Content: prompt},
+		},
+	}
+	headers := map[string]string{
+		"x-api-key":         a.APIKey,
+		"anthropic-version": "2023-06-01",
+	}
+
+	resp, respBody, err := httpjson.Post(a.BaseURL+"/messages", headers, reqBody)
+	if err != nil {
+		return "", fmt.Errorf("calling Anthropic API: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("anthropic API error (HTTP %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	var apiResp anthropicResponse
+	if err := json.Unmarshal(respBody, &apiResp); err != nil {
+		return "", fmt.Errorf("parsing Anthropic response: %w", err)
+	}
+
+	// Extract text from response
+	var text string
+	for _, block := range apiResp.Content {
+		if block.Type == "text" {
+			text += block.Text
+		}
+	}
+
+	if text == "" {
+		return "", fmt.Errorf("empty response from Anthropic API")
+	}
+	return text, nil
+}
+
+// Chat streams the reply to messages to w and returns it.
+func (a *Anthropic) Chat(systemPrompt string, messages []meeting.ChatMessage, w io.Writer) (string, error) {
+	if a.APIKey == "" {
+		return "", fmt.Errorf("anthropic API key not set: set MEETINGCLI_ANTHROPIC_API_KEY or add anthropic_api_key to config")
+	}
+
+	reqBody := anthropicRequest{
+		Model:       a.opts.Model,
+		MaxTokens:   a.opts.maxTokens(),
+		Temperature: a.opts.Temperature,
+		System:      systemPrompt,
+		Messages:    toChatMessages("", messages),
+		Stream:      true,
+	}
+	headers := map[string]string{
+		"x-api-key":         a.APIKey,
+		"anthropic-version": "2023-06-01",
+	}
+
+	var reply strings.Builder
+	err := httpjson.PostStream(a.BaseURL+"/messages", headers, reqBody, func(line string) error {
+		data, ok := sseData(line)
+		if !ok {
+			return nil
+		}
+		var event anthropicStreamEvent
+		if err := json.Unmarshal([]byte(data), &event); err != nil {
+			return fmt.Errorf("parsing Anthropic stream: %w", err)
+		}
+		switch event.Type {
+		case "error":
+			return fmt.Errorf("anthropic API error: %s", event.Error.Message)
+		case "content_block_delta":
+			if event.Delta.Type == "text_delta" {
+				reply.WriteString(event.Delta.Text)
+				_, err := io.WriteString(w, event.Delta.Text)
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("calling Anthropic API: %w", err)
+	}
+
+	if reply.Len() == 0 {
+		return "", fmt.Errorf("empty response from Anthropic API")
+	}
+	return reply.String(), nil
+}
+
+type chatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type anthropicRequest struct {
+	Model       string        `json:"model"`
+	MaxTokens   int           `json:"max_tokens"`
+	Temperature *float64      `json:"temperature,omitempty"`
+	System      string        `json:"system,omitempty"`
+	Messages    []chatMessage `json:"messages"`
+	Stream      bool          `json:"stream,omitempty"`
+}
+
+type anthropicStreamEvent struct {
+	Type  string `json:"type"`
+	Delta struct {
+		Type string `json:"type"`
+		Text string `json:"text"`
+	} `json:"delta"`
+	Error struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+type anthropicResponse struct {
+	Content []struct {
+		Type string `json:"type"`
+		Text string `json:"text"`
+	} `json:"content"`
+}
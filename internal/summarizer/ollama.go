@@ -0,0 +1,121 @@
+package summarizer
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/devbydaniel/meetingcli/internal/domain/meeting"
+	"github.com/devbydaniel/meetingcli/internal/httpjson"
+)
+
+const (
+	DefaultOllamaBaseURL = "http://localhost:11434"
+	DefaultOllamaModel   = "llama3.1"
+)
+
+// Ollama summarizes with a local model via Ollama's /api/chat, so
+// transcripts never leave the machine.
+type Ollama struct {
+	BaseURL string
+	opts    Options
+}
+
+func NewOllama(baseURL string, opts Options) *Ollama {
+	if baseURL == "" {
+		baseURL = DefaultOllamaBaseURL
+	}
+	if opts.Model == "" {
+		opts.Model = DefaultOllamaModel
+	}
+	return &Ollama{BaseURL: strings.TrimRight(baseURL, "/"), opts: opts}
+}
+
+func (o *Ollama) Model() string {
+	return o.opts.Model
+}
+
+func (o *Ollama) Summarize(systemPrompt, prompt string) (string, error) {
+	reqBody := ollamaRequest{
+		Model:    o.opts.Model,
+		Messages: chatMessages(systemPrompt, prompt),
+		Stream:   false,
+		Options: ollamaOptions{
+			NumPredict:  o.opts.maxTokens(),
+			Temperature: o.opts.Temperature,
+		},
+	}
+
+	resp, respBody, err := httpjson.Post(o.BaseURL+"/api/chat", nil, reqBody)
+	if err != nil {
+		return "", fmt.Errorf("calling Ollama at %s: %w", o.BaseURL, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("ollama error (HTTP %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	var apiResp ollamaResponse
+	if err := json.Unmarshal(respBody, &apiResp); err != nil {
+		return "", fmt.Errorf("parsing Ollama response: %w", err)
+	}
+
+	if apiResp.Message.Content == "" {
+		return "", fmt.Errorf("empty response from Ollama")
+	}
+	return apiResp.Message.Content, nil
+}
+
+// Chat streams the reply to messages to w and returns it.
+func (o *Ollama) Chat(systemPrompt string, messages []meeting.ChatMessage, w io.Writer) (string, error) {
+	reqBody := ollamaRequest{
+		Model:    o.opts.Model,
+		Messages: toChatMessages(systemPrompt, messages),
+		Stream:   true,
+		Options: ollamaOptions{
+			NumPredict:  o.opts.maxTokens(),
+			Temperature: o.opts.Temperature,
+		},
+	}
+
+	var reply strings.Builder
+	err := httpjson.PostStream(o.BaseURL+"/api/chat", nil, reqBody, func(line string) error {
+		var chunk ollamaResponse
+		if err := json.Unmarshal([]byte(line), &chunk); err != nil {
+			return fmt.Errorf("parsing Ollama stream: %w", err)
+		}
+		if chunk.Error != "" {
+			return fmt.Errorf("ollama error: %s", chunk.Error)
+		}
+		reply.WriteString(chunk.Message.Content)
+		_, err := io.WriteString(w, chunk.Message.Content)
+		return err
+	})
+	if err != nil {
+		return "", fmt.Errorf("calling Ollama at %s: %w", o.BaseURL, err)
+	}
+
+	if reply.Len() == 0 {
+		return "", fmt.Errorf("empty response from Ollama")
+	}
+	return reply.String(), nil
+}
+
+type ollamaRequest struct {
+	Model    string        `json:"model"`
+	Messages []chatMessage `json:"messages"`
+	Stream   bool          `json:"stream"`
+	Options  ollamaOptions `json:"options"`
+}
+
+type ollamaOptions struct {
+	NumPredict  int      `json:"num_predict,omitempty"`
+	Temperature *float64 `json:"temperature,omitempty"`
+}
+
+type ollamaResponse struct {
+	Message chatMessage `json:"message"`
+	Error   string      `json:"error"` // set on errors mid-stream
+}
@@ -0,0 +1,143 @@
+package summarizer
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/devbydaniel/meetingcli/internal/domain/meeting"
+	"github.com/devbydaniel/meetingcli/internal/httpjson"
+)
+
+const (
+	DefaultOpenAIBaseURL = "https://api.openai.com/v1"
+	DefaultOpenAIModel   = "gpt-4o-mini"
+)
+
+// OpenAI summarizes via an OpenAI-compatible /v1/chat/completions endpoint:
+// OpenAI, Groq, OpenRouter, or local servers such as llama.cpp, vLLM and
+// LM Studio.
+type OpenAI struct {
+	APIKey  string // optional for self-hosted servers
+	BaseURL string
+	opts    Options
+}
+
+func NewOpenAI(apiKey, baseURL string, opts Options) *OpenAI {
+	if baseURL == "" {
+		baseURL = DefaultOpenAIBaseURL
+	}
+	if opts.Model == "" {
+		opts.Model = DefaultOpenAIModel
+	}
+	return &OpenAI{APIKey: apiKey, BaseURL: strings.TrimRight(baseURL, "/"), opts: opts}
+}
+
+func (o *OpenAI) Model() string {
+	return o.opts.Model
+}
+
+func (o *OpenAI) Summarize(systemPrompt, prompt string) (string, error) {
+	reqBody := openAIRequest{
+		Model:       o.opts.Model,
+		MaxTokens:   o.opts.maxTokens(),
+		Temperature: o.opts.Temperature,
+		Messages:    chatMessages(systemPrompt, prompt),
+	}
+	headers := map[string]string{}
+	if o.APIKey != "" {
+		headers["Authorization"] = "Bearer " + o.APIKey
+	}
+
+	resp, respBody, err := httpjson.Post(o.BaseURL+"/chat/completions", headers, reqBody)
+	if err != nil {
+		return "", fmt.Errorf("calling chat API at %s: %w", o.BaseURL, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("chat API error (HTTP %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	var apiResp openAIResponse
+	if err := json.Unmarshal(respBody, &apiResp); err != nil {
+		return "", fmt.Errorf("parsing chat response: %w", err)
+	}
+
+	if len(apiResp.Choices) == 0 || apiResp.Choices[0].Message.Content == "" {
+		return "", fmt.Errorf("empty response from chat API")
+	}
+	return apiResp.Choices[0].Message.Content, nil
+}
+
+// Chat streams the reply to messages to w and returns it.
+func (o *OpenAI) Chat(systemPrompt string, messages []meeting.ChatMessage, w io.Writer) (string, error) {
+	reqBody := openAIRequest{
+		Model:       o.opts.Model,
+		MaxTokens:   o.opts.maxTokens(),
+		Temperature: o.opts.Temperature,
+		Messages:    toChatMessages(systemPrompt, messages),
+		Stream:      true,
+	}
+	headers := map[string]string{}
+	if o.APIKey != "" {
+		headers["Authorization"] = "Bearer " + o.APIKey
+	}
+
+	var reply strings.Builder
+	err := httpjson.PostStream(o.BaseURL+"/chat/completions", headers, reqBody, func(line string) error {
+		data, ok := sseData(line)
+		if !ok || data == "[DONE]" {
+			return nil
+		}
+		var chunk openAIStreamChunk
+		if err := json.Unmarshal([]byte(data), &chunk); err != nil {
+			return fmt.Errorf("parsing chat stream: %w", err)
+		}
+		if len(chunk.Choices) == 0 {
+			return nil
+		}
+		text := chunk.Choices[0].Delta.Content
+		reply.WriteString(text)
+		_, err := io.WriteString(w, text)
+		return err
+	})
+	if err != nil {
+		return "", fmt.Errorf("calling chat API at %s: %w", o.BaseURL, err)
+	}
+
+	if reply.Len() == 0 {
+		return "", fmt.Errorf("empty response from chat API")
+	}
+	return reply.String(), nil
+}
+
+// chatMessages builds a system + user conversation, omitting an empty system prompt.
+func chatMessages(systemPrompt, prompt string) []chatMessage {
+	var messages []chatMessage
+	if systemPrompt != "" {
+		messages = append(messages, chatMessage{Role: "system", Content: systemPrompt})
+	}
+	return append(messages, chatMessage{Role: "user", Content: prompt})
+}
+
+type openAIRequest struct {
+	Model       string        `json:"model"`
+	MaxTokens   int           `json:"max_tokens"`
+	Temperature *float64      `json:"temperature,omitempty"`
+	Messages    []chatMessage `json:"messages"`
+	Stream      bool          `json:"stream,omitempty"`
+}
+
+type openAIStreamChunk struct {
+	Choices []struct {
+		Delta chatMessage `json:"delta"`
+	} `json:"choices"`
+}
+
+type openAIResponse struct {
+	Choices []struct {
+		Message chatMessage `json:"message"`
+	} `json:"choices"`
+}
@@ -0,0 +1,45 @@
+// Package summarizer implements the usecases.Summarizer and usecases.Chatter
+// interfaces on top of chat-style language model APIs.
+package summarizer
+
+import (
+	"strings"
+
+	"github.com/devbydaniel/meetingcli/internal/domain/meeting"
+)
+
+// Options are the generation settings shared by all providers.
+type Options struct {
+	Model       string   // empty uses the provider default
+	MaxTokens   int      // maximum length of the reply
+	Temperature *float64 // nil uses the provider default
+}
+
+// DefaultMaxTokens is used when Options.MaxTokens is zero.
+const DefaultMaxTokens = 4096
+
+func (o Options) maxTokens() int {
+	if o.MaxTokens <= 0 {
+		return DefaultMaxTokens
+	}
+	return o.MaxTokens
+}
+
+// sseData returns the payload of a server-sent "data:" line.
+func sseData(line string) (string, bool) {
+	data, ok := strings.CutPrefix(line, "data:")
+	return strings.TrimSpace(data), ok
+}
+
+// toChatMessages converts a conversation to the wire format, led by the
+// system prompt if there is one.
+func toChatMessages(systemPrompt string, messages []meeting.ChatMessage) []chatMessage {
+	var result []chatMessage
+	if systemPrompt != "" {
+		result = append(result, chatMessage{Role: "system", Content: systemPrompt})
+	}
+	for _, m := range messages {
+		result = append(result, chatMessage{Role: m.Role, Content: m.Content})
+	}
+	return result
+}
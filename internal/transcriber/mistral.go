@@ -0,0 +1,90 @@
+package transcriber
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/devbydaniel/meetingcli/internal/domain/meeting"
+)
+
+const (
+	DefaultMistralBaseURL = "https://api.mistral.ai/v1"
+	DefaultMistralModel   = "voxtral-mini-latest"
+)
+
+// Mistral transcribes audio with speaker diarization via the Mistral Voxtral API.
+type Mistral struct {
+	APIKey  string
+	BaseURL string
+	model   string
+}
+
+func NewMistral(apiKey, baseURL, model string) *Mistral {
+	if baseURL == "" {
+		baseURL = DefaultMistralBaseURL
+	}
+	if model == "" {
+		model = DefaultMistralModel
+	}
+	return &Mistral{APIKey: apiKey, BaseURL: strings.TrimRight(baseURL, "/"), model: model}
+}
+
+func (m *Mistral) Model() string {
+	return m.model
+}
+
+// Transcribe sends audioPath to the Mistral transcription endpoint.
+func (m *Mistral) Transcribe(audioPath string) (*meeting.Transcript, error) {
+	if m.APIKey == "" {
+		return nil, fmt.Errorf("mistral API key not set: set MEETINGCLI_MISTRAL_API_KEY or add mistral_api_key to config")
+	}
+
+	fields := []formField{
+		{"model", m.model},
+		{"diarize", "true"},
+		// Required when diarize is true
+		{"timestamp_granularities[]", "segment"},
+	}
+	resp, respBody, err := postAudio(m.BaseURL+"/audio/transcriptions", m.APIKey, fields, audioPath)
+	if err != nil {
+		return nil, fmt.Errorf("calling Mistral API: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("mistral API error (HTTP %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	// Parse response
+	var apiResp mistralResponse
+	if err := json.Unmarshal(respBody, &apiResp); err != nil {
+		return nil, fmt.Errorf("parsing Mistral response: %w", err)
+	}
+
+	result := &meeting.Transcript{
+		Text: apiResp.Text,
+	}
+
+	// Extract diarized segments if available
+	for _, seg := range apiResp.Segments {
+		result.Segments = append(result.Segments, meeting.TranscriptSegment{
+			Speaker: seg.SpeakerID,
+			Text:    seg.Text,
+			Start:   seg.Start,
+			End:     seg.End,
+		})
+	}
+	return result, nil
+}
+
+// mistralResponse matches the Mistral transcription API response.
+type mistralResponse struct {
+	Text     string `json:"text"`
+	Segments []struct {
+		SpeakerID string  `json:"speaker_id"`
+		Text      string  `json:"text"`
+		Start     float64 `json:"start"`
+		End       float64 `json:"end"`
+	} `json:"segments"`
+}
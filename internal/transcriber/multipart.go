@@ -0,0 +1,74 @@
+package transcriber
+
+import (
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+// formField is a multipart form field. Fields may repeat, so a map won't do.
+type formField struct {
+	name, value string
+}
+
+// postAudio uploads audioPath with fields as a multipart form and returns the
+// response body. The body is streamed from disk rather than buffered in memory.
+func postAudio(url, apiKey string, fields []formField, audioPath string) (*http.Response, []byte, error) {
+	file, err := os.Open(audioPath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("opening audio file: %w", err)
+	}
+	defer file.Close()
+
+	// Build multipart request
+	body, pw := io.Pipe()
+	writer := multipart.NewWriter(pw)
+	go func() {
+		pw.CloseWithError(writeForm(writer, fields, file, filepath.Base(audioPath)))
+	}()
+
+	// Make request
+	req, err := http.NewRequest("POST", url, body)
+	if err != nil {
+		body.Close()
+		return nil, nil, err
+	}
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	if apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+apiKey)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return resp, nil, fmt.Errorf("reading response: %w", err)
+	}
+	return resp, respBody, nil
+}
+
+func writeForm(writer *multipart.Writer, fields []formField, audio io.Reader, filename string) error {
+	for _, f := range fields {
+		if err := writer.WriteField(f.name, f.value); err != nil {
+			return err
+		}
+	}
+
+	// Add audio file
+	part, err := writer.CreateFormFile("file", filename)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(part, audio); err != nil {
+		return err
+	}
+
+	return writer.Close()
+}
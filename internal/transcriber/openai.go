@@ -0,0 +1,94 @@
+package transcriber
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"strings"
+
+	"github.com/devbydaniel/meetingcli/internal/domain/meeting"
+)
+
+const (
+	DefaultOpenAIBaseURL = "https://api.openai.com/v1"
+	DefaultOpenAIModel   = "whisper-1"
+)
+
+// OpenAI transcribes audio via an OpenAI-compatible /v1/audio/transcriptions
+// endpoint: OpenAI, Groq, or self-hosted servers such as faster-whisper-server
+// and LocalAI. These endpoints don't diarize, so segments have no speaker.
+type OpenAI struct {
+	APIKey  string // optional for self-hosted servers
+	BaseURL string
+	model   string
+}
+
+func NewOpenAI(apiKey, baseURL, model string) *OpenAI {
+	if baseURL == "" {
+		baseURL = DefaultOpenAIBaseURL
+	}
+	if model == "" {
+		model = DefaultOpenAIModel
+	}
+	return &OpenAI{APIKey: apiKey, BaseURL: strings.TrimRight(baseURL, "/"), model: model}
+}
+
+func (o *OpenAI) Model() string {
+	return o.model
+}
+
+// Transcribe sends audioPath to the transcription endpoint, asking for
+// verbose JSON to get segment timestamps.
+func (o *OpenAI) Transcribe(audioPath string) (*meeting.Transcript, error) {
+	fields := []formField{
+		{"model", o.model},
+		{"response_format", "verbose_json"},
+		{"timestamp_granularities[]", "segment"},
+	}
+	resp, respBody, err := postAudio(o.BaseURL+"/audio/transcriptions", o.APIKey, fields, audioPath)
+	if err != nil {
+		return nil, fmt.Errorf("calling transcription API at %s: %w", o.BaseURL, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("transcription API error (HTTP %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	var apiResp openAIResponse
+	if err := json.Unmarshal(respBody, &apiResp); err != nil {
+		return nil, fmt.Errorf("parsing transcription response: %w", err)
+	}
+
+	return apiResp.transcript(), nil
+}
+
+// openAIResponse matches the verbose_json transcription response.
+type openAIResponse struct {
+	Text     string `json:"text"`
+	Segments []struct {
+		Text       string   `json:"text"`
+		Start      float64  `json:"start"`
+		End        float64  `json:"end"`
+		AvgLogprob *float64 `json:"avg_logprob"`
+	} `json:"segments"`
+}
+
+func (r *openAIResponse) transcript() *meeting.Transcript {
+	result := &meeting.Transcript{
+		Text: strings.TrimSpace(r.Text),
+	}
+	for _, seg := range r.Segments {
+		segment := meeting.TranscriptSegment{
+			Text:  strings.TrimSpace(seg.Text),
+			Start: seg.Start,
+			End:   seg.End,
+		}
+		if seg.AvgLogprob != nil {
+			// Mean token probability, as a rough per-segment confidence.
+			segment.Confidence = math.Exp(*seg.AvgLogprob)
+		}
+		result.Segments = append(result.Segments, segment)
+	}
+	return result
+}
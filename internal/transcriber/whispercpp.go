@@ -0,0 +1,149 @@
+package transcriber
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/devbydaniel/meetingcli/internal/domain/meeting"
+)
+
+// DefaultWhisperBinary is the whisper.cpp CLI looked up on PATH when no binary is configured.
+const DefaultWhisperBinary = "whisper-cli"
+
+// WhisperCpp transcribes audio fully offline with whisper.cpp, either by
+// running its CLI or by calling a whisper.cpp server. whisper.cpp doesn't
+// diarize, so segments have no speaker.
+type WhisperCpp struct {
+	Binary    string // whisper.cpp CLI, used when ServerURL is empty
+	ModelPath string // ggml model file passed to the CLI
+	ServerURL string // base URL of a whisper.cpp server, e.g. http://127.0.0.1:8080
+}
+
+func NewWhisperCpp(binary, modelPath, serverURL string) *WhisperCpp {
+	if binary == "" {
+		binary = DefaultWhisperBinary
+	}
+	return &WhisperCpp{Binary: binary, ModelPath: modelPath, ServerURL: strings.TrimRight(serverURL, "/")}
+}
+
+func (w *WhisperCpp) Model() string {
+	if w.ServerURL != "" {
+		return "whisper.cpp server"
+	}
+	return "whisper.cpp:" + filepath.Base(w.ModelPath)
+}
+
+// Check verifies that the binary and model file exist (CLI mode only).
+func (w *WhisperCpp) Check() error {
+	if w.ServerURL != "" {
+		return nil
+	}
+	if _, err := exec.LookPath(w.Binary); err != nil {
+		return fmt.Errorf("whisper.cpp binary %q not found: set [transcription] binary in config", w.Binary)
+	}
+	if w.ModelPath == "" {
+		return fmt.Errorf("whisper.cpp model not set: set [transcription] model_path in config")
+	}
+	if _, err := os.Stat(w.ModelPath); err != nil {
+		return fmt.Errorf("whisper.cpp model: %w", err)
+	}
+	return nil
+}
+
+// Transcribe runs whisper.cpp on audioPath, which must be a 16kHz WAV.
+func (w *WhisperCpp) Transcribe(audioPath string) (*meeting.Transcript, error) {
+	if w.ServerURL != "" {
+		return w.transcribeServer(audioPath)
+	}
+	return w.transcribeCLI(audioPath)
+}
+
+func (w *WhisperCpp) transcribeCLI(audioPath string) (*meeting.Transcript, error) {
+	if err := w.Check(); err != nil {
+		return nil, err
+	}
+
+	tmpDir, err := os.MkdirTemp("", "meetingcli-whisper-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmpDir)
+	outPrefix := filepath.Join(tmpDir, "transcript")
+
+	// -oj writes <prefix>.json, -np keeps stdout quiet.
+	cmd := exec.Command(w.Binary,
+		"-m", w.ModelPath,
+		"-f", audioPath,
+		"-oj",
+		"-of", outPrefix,
+		"-np",
+	)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return nil, fmt.Errorf("running whisper.cpp: %w\n%s", err, string(out))
+	}
+
+	data, err := os.ReadFile(outPrefix + ".json")
+	if err != nil {
+		return nil, fmt.Errorf("reading whisper.cpp output: %w", err)
+	}
+
+	var out whisperCLIOutput
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, fmt.Errorf("parsing whisper.cpp output: %w", err)
+	}
+
+	result := &meeting.Transcript{}
+	var texts []string
+	for _, seg := range out.Transcription {
+		text := strings.TrimSpace(seg.Text)
+		if text == "" {
+			continue
+		}
+		texts = append(texts, text)
+		result.Segments = append(result.Segments, meeting.TranscriptSegment{
+			Text:  text,
+			Start: float64(seg.Offsets.From) / 1000,
+			End:   float64(seg.Offsets.To) / 1000,
+		})
+	}
+	result.Text = strings.Join(texts, " ")
+	return result, nil
+}
+
+// transcribeServer posts to the whisper.cpp server's /inference endpoint,
+// which answers verbose_json in the OpenAI format.
+func (w *WhisperCpp) transcribeServer(audioPath string) (*meeting.Transcript, error) {
+	fields := []formField{
+		{"response_format", "verbose_json"},
+	}
+	resp, respBody, err := postAudio(w.ServerURL+"/inference", "", fields, audioPath)
+	if err != nil {
+		return nil, fmt.Errorf("calling whisper.cpp server at %s: %w", w.ServerURL, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("whisper.cpp server error (HTTP %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	var apiResp openAIResponse
+	if err := json.Unmarshal(respBody, &apiResp); err != nil {
+		return nil, fmt.Errorf("parsing whisper.cpp server response: %w", err)
+	}
+	return apiResp.transcript(), nil
+}
+
+// whisperCLIOutput matches the JSON written by whisper.cpp's -oj flag.
+type whisperCLIOutput struct {
+	Transcription []struct {
+		Offsets struct {
+			From int64 `json:"from"` // milliseconds
+			To   int64 `json:"to"`
+		} `json:"offsets"`
+		Text string `json:"text"`
+	} `json:"transcription"`
+}